@@ -98,7 +98,7 @@ func (c *Client) GetAccountBetaProgram(ctx context.Context, betaID string) (*Acc
 
 // JoinBetaProgram enrolls an account into a beta program.
 func (c *Client) JoinBetaProgram(ctx context.Context, opts AccountBetaProgramCreateOpts) (*AccountBetaProgram, error) {
-	body, err := json.Marshal(opts)
+	body, err := c.marshalJSON(opts)
 	if err != nil {
 		return nil, err
 	}
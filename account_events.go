@@ -279,7 +279,11 @@ func (resp *EventsPagedResponse) castResult(r *resty.Request, e string) (int, in
 
 // ListEvents gets a collection of Event objects representing actions taken
 // on the Account. The Events returned depend on the token grants and the grants
-// of the associated user.
+// of the associated user. The events can be filtered by username and created, e.g. for
+// the events of a user in a time window:
+//
+//	f := linodego.NewFilter().Eq("username", "example").Gte("created", from).Lt("created", to)
+//	events, err := client.ListEvents(ctx, linodego.NewListOptions(0, f.String()))
 func (c *Client) ListEvents(ctx context.Context, opts *ListOptions) ([]Event, error) {
 	response := EventsPagedResponse{}
 	err := c.listHelper(ctx, &response, opts)
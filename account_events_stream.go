@@ -0,0 +1,89 @@
+package linodego
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+)
+
+// eventStreamMaxBackoff is the maximum multiple of the client poll delay that
+// StreamEvents waits between polls while no new events are returned.
+const eventStreamMaxBackoff = 8
+
+// StreamEvents polls the events endpoint until ctx is done and calls handler once
+// for each new Event created since the given time, in the order of their IDs.
+// The delay between polls starts at the client poll delay and doubles while no
+// new events are returned or fetching them fails, up to 8 times the poll delay.
+// Errors fetching events are passed to errHandler and polling continues; if
+// errHandler is nil, StreamEvents returns the first error instead.
+// StreamEvents returns the context error once ctx is done, or ErrClientClosed once the client is closed.
+func (c *Client) StreamEvents(ctx context.Context, since time.Time, handler func(Event), errHandler func(error)) error {
+	lastEventID := 0
+
+	interval := c.pollInterval
+	maxInterval := c.pollInterval * eventStreamMaxBackoff
+
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timer.C:
+		}
+
+		filter := NewFilter().
+			Gte("created", since.UTC().Format("2006-01-02T15:04:05")).
+			SetOrder("id", Ascending)
+
+		if lastEventID > 0 {
+			filter.Gte("id", lastEventID)
+		}
+
+		events, err := c.ListEvents(ctx, NewListOptions(0, filter.String()))
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+
+			if errors.Is(err, ErrClientClosed) {
+				return err
+			}
+
+			if errHandler == nil {
+				return err
+			}
+
+			errHandler(err)
+		}
+
+		sort.Slice(events, func(i, j int) bool {
+			return events[i].ID < events[j].ID
+		})
+
+		newEvents := 0
+
+		for _, event := range events {
+			if event.ID <= lastEventID {
+				continue
+			}
+
+			lastEventID = event.ID
+			newEvents++
+
+			handler(event)
+		}
+
+		if newEvents > 0 {
+			interval = c.pollInterval
+		} else if interval*2 <= maxInterval {
+			interval *= 2
+		} else {
+			interval = maxInterval
+		}
+
+		timer.Reset(interval)
+	}
+}
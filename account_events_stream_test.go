@@ -0,0 +1,81 @@
+package linodego
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestClient_StreamEvents(t *testing.T) {
+	requests := 0
+
+	ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		requests++
+
+		rw.Header().Set("Content-Type", "application/json")
+
+		var events []map[string]any
+
+		switch requests {
+		case 1:
+			events = []map[string]any{{"id": 2}, {"id": 1}}
+		case 2:
+			rw.WriteHeader(http.StatusBadRequest)
+			rw.Write([]byte(`{"errors": [{"reason": "Bad request"}]}`))
+
+			return
+		case 3:
+			// The last event is returned again as the filter includes it
+			events = []map[string]any{{"id": 2}, {"id": 3}}
+		default:
+			events = []map[string]any{}
+		}
+
+		json.NewEncoder(rw).Encode(map[string]any{"data": events, "page": 1, "pages": 1, "results": len(events)})
+	}))
+	defer ts.Close()
+
+	client := NewClient(nil)
+	client.SetBaseURL(ts.URL)
+	client.SetPollDelay(time.Millisecond)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var received []int
+	var errs []error
+
+	err := client.StreamEvents(ctx, time.Now(), func(e Event) {
+		received = append(received, e.ID)
+
+		if e.ID == 3 {
+			cancel()
+		}
+	}, func(err error) {
+		errs = append(errs, err)
+	})
+
+	if err != context.Canceled {
+		t.Errorf("expected the stream to stop with the context error, got %v", err)
+	}
+
+	if !reflect.DeepEqual(received, []int{1, 2, 3}) {
+		t.Errorf("expected events 1, 2 and 3 in order, got %v", received)
+	}
+
+	if len(errs) != 1 {
+		t.Errorf("expected 1 error to be handled, got %v", errs)
+	}
+
+	// Without an error handler the error is returned
+	requests = 1
+	err = client.StreamEvents(context.Background(), time.Now(), func(Event) {}, nil)
+
+	if err == nil {
+		t.Error("expected the error to be returned without an error handler")
+	}
+}
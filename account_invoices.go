@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/go-resty/resty/v2"
@@ -12,23 +13,39 @@ import (
 
 // Invoice structs reflect an invoice for billable activity on the account.
 type Invoice struct {
-	ID    int        `json:"id"`
-	Label string     `json:"label"`
-	Total float32    `json:"total"`
-	Date  *time.Time `json:"-"`
+	ID         int                 `json:"id"`
+	Label      string              `json:"label"`
+	Subtotal   float32             `json:"subtotal"`
+	Tax        float32             `json:"tax"`
+	TaxSummary []InvoiceTaxSummary `json:"tax_summary"`
+	Total      float32             `json:"total"`
+	Date       *time.Time          `json:"-"`
+}
+
+// InvoiceTaxSummary is the amount of a single tax applied to an Invoice
+type InvoiceTaxSummary struct {
+	Name string  `json:"name"`
+	Tax  float32 `json:"tax"`
 }
 
 // InvoiceItem structs reflect a single billable activity associate with an Invoice
 type InvoiceItem struct {
-	Label     string     `json:"label"`
-	Type      string     `json:"type"`
-	UnitPrice int        `json:"unitprice"`
-	Quantity  int        `json:"quantity"`
-	Amount    float32    `json:"amount"`
-	Tax       float32    `json:"tax"`
-	Region    *string    `json:"region"`
-	From      *time.Time `json:"-"`
-	To        *time.Time `json:"-"`
+	Label string `json:"label"`
+	Type  string `json:"type"`
+
+	// Deprecated: UnitPrice is not returned by the API, use Price instead
+	UnitPrice int `json:"unitprice"`
+
+	// Price is the price of a single unit of the item, parsed from the unit_price returned by the API
+	Price float64 `json:"-"`
+
+	Quantity int        `json:"quantity"`
+	Amount   float32    `json:"amount"`
+	Tax      float32    `json:"tax"`
+	Total    float32    `json:"total"`
+	Region   *string    `json:"region"`
+	From     *time.Time `json:"-"`
+	To       *time.Time `json:"-"`
 }
 
 // InvoicesPagedResponse represents a paginated Invoice API response
@@ -89,8 +106,9 @@ func (i *InvoiceItem) UnmarshalJSON(b []byte) error {
 
 	p := struct {
 		*Mask
-		From *parseabletime.ParseableTime `json:"from"`
-		To   *parseabletime.ParseableTime `json:"to"`
+		From      *parseabletime.ParseableTime `json:"from"`
+		To        *parseabletime.ParseableTime `json:"to"`
+		UnitPrice json.RawMessage              `json:"unit_price"`
 	}{
 		Mask: (*Mask)(i),
 	}
@@ -102,6 +120,23 @@ func (i *InvoiceItem) UnmarshalJSON(b []byte) error {
 	i.From = (*time.Time)(p.From)
 	i.To = (*time.Time)(p.To)
 
+	// The unit price is a decimal string, but may be returned as a number
+	if len(p.UnitPrice) > 0 && string(p.UnitPrice) != "null" {
+		var price string
+		if err := json.Unmarshal(p.UnitPrice, &price); err != nil {
+			price = string(p.UnitPrice)
+		}
+
+		if price != "" {
+			parsed, err := strconv.ParseFloat(price, 64)
+			if err != nil {
+				return fmt.Errorf("failed to parse unit price %q: %w", price, err)
+			}
+
+			i.Price = parsed
+		}
+	}
+
 	return nil
 }
 
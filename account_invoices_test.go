@@ -0,0 +1,36 @@
+package linodego
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestInvoiceItem_UnmarshalJSON(t *testing.T) {
+	var item InvoiceItem
+	if err := json.Unmarshal([]byte(`{
+		"label": "Linode 2GB",
+		"type": "hourly",
+		"unit_price": "0.015",
+		"quantity": 720,
+		"amount": 10.8,
+		"tax": 0.5,
+		"total": 11.3,
+		"from": "2024-01-01T00:00:00",
+		"to": "2024-01-31T00:00:00"
+	}`), &item); err != nil {
+		t.Fatal(err)
+	}
+
+	if item.Price != 0.015 || item.Quantity != 720 || item.Total != 11.3 {
+		t.Errorf("unexpected invoice item: %+v", item)
+	}
+
+	if item.From == nil || !item.From.Equal(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("unexpected from date: %v", item.From)
+	}
+
+	if err := json.Unmarshal([]byte(`{"unit_price": 2.5}`), &item); err != nil || item.Price != 2.5 {
+		t.Errorf("expected a numeric unit price to be parsed, got %v (%v)", item.Price, err)
+	}
+}
@@ -10,20 +10,32 @@ import (
 	"github.com/linode/linodego/internal/parseabletime"
 )
 
+// Login represents a login attempt to the Account
 type Login struct {
-	ID         int        `json:"id"`
-	Datetime   *time.Time `json:"datetime"`
-	IP         string     `json:"ip"`
-	Restricted bool       `json:"restricted"`
-	Username   string     `json:"username"`
-	Status     string     `json:"status"`
+	ID         int         `json:"id"`
+	Datetime   *time.Time  `json:"datetime"`
+	IP         string      `json:"ip"`
+	Restricted bool        `json:"restricted"`
+	Username   string      `json:"username"`
+	Status     LoginStatus `json:"status"`
 }
 
+// LoginStatus is the result of a login attempt
+type LoginStatus string
+
+// LoginStatus constants reflect whether a login attempt succeeded
+const (
+	LoginSuccessful LoginStatus = "successful"
+	LoginFailed     LoginStatus = "failed"
+)
+
+// LoginsPagedResponse represents a paginated Login API response
 type LoginsPagedResponse struct {
 	*PageOptions
 	Data []Login `json:"data"`
 }
 
+// endpoint gets the endpoint URL for Login
 func (LoginsPagedResponse) endpoint(_ ...any) string {
 	return "account/logins"
 }
@@ -38,6 +50,11 @@ func (resp *LoginsPagedResponse) castResult(r *resty.Request, e string) (int, in
 	return castedRes.Pages, castedRes.Results, nil
 }
 
+// ListLogins lists the login attempts to the Account, most recent first. The logins can be
+// filtered by username, ip, status and datetime, e.g. for the logins of a user since a time:
+//
+//	f := linodego.NewFilter().Eq("username", "example").Gte("datetime", since)
+//	logins, err := client.ListLogins(ctx, linodego.NewListOptions(0, f.String()))
 func (c *Client) ListLogins(ctx context.Context, opts *ListOptions) ([]Login, error) {
 	response := LoginsPagedResponse{}
 	err := c.listHelper(ctx, &response, opts)
@@ -68,6 +85,7 @@ func (i *Login) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
+// GetLogin gets the login attempt with the provided ID
 func (c *Client) GetLogin(ctx context.Context, loginID int) (*Login, error) {
 	req := c.R(ctx).SetResult(&Login{})
 	e := fmt.Sprintf("account/logins/%d", loginID)
@@ -0,0 +1,55 @@
+package linodego
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestClient_ListLogins(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v4/account/logins" {
+			rw.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		expected := `{"datetime":{"+gte":"2024-01-02T00:00:00"},"username":"example"}`
+		if filter := r.Header.Get("X-Filter"); filter != expected {
+			t.Errorf("expected filter %s, got %s", expected, filter)
+		}
+
+		rw.Header().Set("Content-Type", "application/json")
+
+		if r.URL.Query().Get("page") == "2" {
+			rw.Write([]byte(`{"data": [{"id": 2, "datetime": "2024-01-03T00:00:00", "ip": "192.0.2.2", "username": "example", "status": "failed"}], "page": 2, "pages": 2, "results": 2}`))
+			return
+		}
+
+		rw.Write([]byte(`{"data": [{"id": 1, "datetime": "2024-01-02T10:00:00", "ip": "192.0.2.1", "username": "example", "status": "successful"}], "page": 1, "pages": 2, "results": 2}`))
+	}))
+	defer ts.Close()
+
+	client := NewClient(nil)
+	client.SetBaseURL(ts.URL)
+
+	filter := NewFilter().Eq("username", "example").Gte("datetime", time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC))
+
+	logins, err := client.ListLogins(context.Background(), NewListOptions(0, filter.String()))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(logins) != 2 {
+		t.Fatalf("expected 2 logins, got %d", len(logins))
+	}
+
+	if logins[0].IP != "192.0.2.1" || logins[0].Status != LoginSuccessful || logins[1].Status != LoginFailed {
+		t.Errorf("unexpected logins %+v", logins)
+	}
+
+	if logins[0].Datetime == nil || !logins[0].Datetime.Equal(time.Date(2024, 1, 2, 10, 0, 0, 0, time.UTC)) {
+		t.Errorf("unexpected datetime %v", logins[0].Datetime)
+	}
+}
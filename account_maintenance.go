@@ -0,0 +1,98 @@
+package linodego
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/linode/linodego/internal/parseabletime"
+)
+
+// AccountMaintenance represents a scheduled or in-progress maintenance affecting an entity on the Account
+type AccountMaintenance struct {
+	Entity *AccountMaintenanceEntity `json:"entity"`
+	Reason string                    `json:"reason"`
+	Status AccountMaintenanceStatus  `json:"status"`
+	Type   AccountMaintenanceType    `json:"type"`
+	When   *time.Time                `json:"-"`
+}
+
+// AccountMaintenanceEntity is the entity affected by an AccountMaintenance
+type AccountMaintenanceEntity struct {
+	ID    int    `json:"id"`
+	Label string `json:"label"`
+	Type  string `json:"type"`
+	URL   string `json:"url"`
+}
+
+// AccountMaintenanceStatus constants start with AccountMaintenance and include all known Linode API Maintenance Statuses.
+type AccountMaintenanceStatus string
+
+// AccountMaintenanceStatus constants represent the progress of an AccountMaintenance. New statuses may be added in the future.
+const (
+	AccountMaintenancePending AccountMaintenanceStatus = "pending"
+	AccountMaintenanceStarted AccountMaintenanceStatus = "started"
+)
+
+// AccountMaintenanceType constants start with AccountMaintenance and include all known Linode API Maintenance Types.
+type AccountMaintenanceType string
+
+// AccountMaintenanceType constants represent the kind of an AccountMaintenance. New types may be added in the future.
+const (
+	AccountMaintenanceReboot        AccountMaintenanceType = "reboot"
+	AccountMaintenanceColdMigration AccountMaintenanceType = "cold_migration"
+	AccountMaintenanceLiveMigration AccountMaintenanceType = "live_migration"
+)
+
+// AccountMaintenancesPagedResponse represents a paginated Account Maintenance API response
+type AccountMaintenancesPagedResponse struct {
+	*PageOptions
+	Data []AccountMaintenance `json:"data"`
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (i *AccountMaintenance) UnmarshalJSON(b []byte) error {
+	type Mask AccountMaintenance
+
+	p := struct {
+		*Mask
+		When *parseabletime.ParseableTime `json:"when"`
+	}{
+		Mask: (*Mask)(i),
+	}
+
+	if err := json.Unmarshal(b, &p); err != nil {
+		return err
+	}
+
+	i.When = (*time.Time)(p.When)
+
+	return nil
+}
+
+// endpoint gets the endpoint URL for AccountMaintenance
+func (AccountMaintenancesPagedResponse) endpoint(_ ...any) string {
+	return "account/maintenance"
+}
+
+func (resp *AccountMaintenancesPagedResponse) castResult(r *resty.Request, e string) (int, int, error) {
+	res, err := coupleAPIErrors(r.SetResult(AccountMaintenancesPagedResponse{}).Get(e))
+	if err != nil {
+		return 0, 0, err
+	}
+	castedRes := res.Result().(*AccountMaintenancesPagedResponse)
+	resp.Data = append(resp.Data, castedRes.Data...)
+	return castedRes.Pages, castedRes.Results, nil
+}
+
+// ListMaintenances lists the scheduled and in-progress maintenance affecting the entities of the Account
+func (c *Client) ListMaintenances(ctx context.Context, opts *ListOptions) ([]AccountMaintenance, error) {
+	response := AccountMaintenancesPagedResponse{}
+	err := c.listHelper(ctx, &response, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
@@ -0,0 +1,63 @@
+package linodego
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestClient_ListMaintenances(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v4/account/maintenance" {
+			rw.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		if filter := r.Header.Get("X-Filter"); filter != `{"status":"pending"}` {
+			t.Errorf("unexpected filter %q", filter)
+		}
+
+		rw.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Query().Get("page") {
+		case "", "1":
+			rw.Write([]byte(`{"data": [{
+				"entity": {"id": 123, "label": "web-01", "type": "linode", "url": "/v4/linode/instances/123"},
+				"reason": "Scheduled host maintenance", "status": "pending", "type": "reboot", "when": "2024-03-01T04:00:00"
+			}], "page": 1, "pages": 2, "results": 2}`))
+		case "2":
+			rw.Write([]byte(`{"data": [{
+				"entity": {"id": 456, "label": "web-02", "type": "linode", "url": "/v4/linode/instances/456"},
+				"reason": "Hardware upgrade", "status": "pending", "type": "cold_migration", "when": "2024-03-02T04:00:00"
+			}], "page": 2, "pages": 2, "results": 2}`))
+		}
+	}))
+	defer ts.Close()
+
+	client := NewClient(nil)
+	client.SetBaseURL(ts.URL)
+
+	maintenances, err := client.ListMaintenances(context.Background(), NewListOptions(0, NewFilter().Eq("status", AccountMaintenancePending).String()))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(maintenances) != 2 {
+		t.Fatalf("expected the maintenances of both pages, got %d", len(maintenances))
+	}
+
+	first := maintenances[0]
+	if first.Entity == nil || first.Entity.ID != 123 || first.Type != AccountMaintenanceReboot || first.Status != AccountMaintenancePending {
+		t.Errorf("unexpected maintenance %+v", first)
+	}
+
+	if expected := time.Date(2024, 3, 1, 4, 0, 0, 0, time.UTC); first.When == nil || !first.When.Equal(expected) {
+		t.Errorf("expected the maintenance to be at %s, got %v", expected, first.When)
+	}
+
+	if maintenances[1].Type != AccountMaintenanceColdMigration {
+		t.Errorf("unexpected maintenance type %q", maintenances[1].Type)
+	}
+}
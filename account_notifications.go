@@ -93,6 +93,40 @@ func (c *Client) ListNotifications(ctx context.Context, opts *ListOptions) ([]No
 	return response.Data, nil
 }
 
+// ListNotificationsBySeverity lists the Notifications of the Account with any of the given severities.
+func (c *Client) ListNotificationsBySeverity(ctx context.Context, severities ...NotificationSeverity) ([]Notification, error) {
+	notifications, err := c.ListNotifications(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	filtered := make([]Notification, 0, len(notifications))
+
+	for _, notification := range notifications {
+		for _, severity := range severities {
+			if notification.Severity == severity {
+				filtered = append(filtered, notification)
+				break
+			}
+		}
+	}
+
+	return filtered, nil
+}
+
+// IsMaintenance reports whether the Notification announces a migration, reboot or other maintenance
+// of its Entity, e.g. so that workloads can be drained from an Instance before it is migrated.
+// The schedule of the maintenance can be found with ListMaintenances.
+func (i Notification) IsMaintenance() bool {
+	switch i.Type {
+	case NotificationMigrationScheduled, NotificationMigrationImminent, NotificationMigrationPending,
+		NotificationRebootScheduled, NotificationMaintenance:
+		return true
+	default:
+		return false
+	}
+}
+
 // UnmarshalJSON implements the json.Unmarshaler interface
 func (i *Notification) UnmarshalJSON(b []byte) error {
 	type Mask Notification
@@ -0,0 +1,31 @@
+package linodego
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestClient_ListNotificationsBySeverity(t *testing.T) {
+	ts, client := createTestServer(http.MethodGet, "/v4/account/notifications", "application/json", `{"data": [
+		{"label": "Scheduled migration", "type": "migration_scheduled", "severity": "critical",
+			"entity": {"id": 123, "label": "web", "type": "linode"}, "when": "2024-01-02T03:04:05", "until": null},
+		{"label": "Payment due", "type": "payment_due", "severity": "major"},
+		{"label": "Notice", "type": "notice", "severity": "minor"}
+	], "page": 1, "pages": 1, "results": 3}`, http.StatusOK)
+	defer ts.Close()
+
+	notifications, err := client.ListNotificationsBySeverity(context.Background(), NotificationCritical, NotificationMajor)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(notifications) != 2 || notifications[0].Entity == nil || notifications[0].Entity.ID != 123 ||
+		notifications[0].When == nil || notifications[1].Type != NotificationPaymentDue {
+		t.Errorf("unexpected notifications %+v", notifications)
+	}
+
+	if !notifications[0].IsMaintenance() || notifications[1].IsMaintenance() {
+		t.Error("expected only the migration to be maintenance")
+	}
+}
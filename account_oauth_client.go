@@ -2,7 +2,6 @@ package linodego
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"net/url"
 
@@ -132,7 +131,7 @@ func (c *Client) GetOAuthClient(ctx context.Context, clientID string) (*OAuthCli
 
 // CreateOAuthClient creates an OAuthClient
 func (c *Client) CreateOAuthClient(ctx context.Context, opts OAuthClientCreateOptions) (*OAuthClient, error) {
-	body, err := json.Marshal(opts)
+	body, err := c.marshalJSON(opts)
 	if err != nil {
 		return nil, err
 	}
@@ -149,7 +148,7 @@ func (c *Client) CreateOAuthClient(ctx context.Context, opts OAuthClientCreateOp
 
 // UpdateOAuthClient updates the OAuthClient with the specified id
 func (c *Client) UpdateOAuthClient(ctx context.Context, clientID string, opts OAuthClientUpdateOptions) (*OAuthClient, error) {
-	body, err := json.Marshal(opts)
+	body, err := c.marshalJSON(opts)
 	if err != nil {
 		return nil, err
 	}
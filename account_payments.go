@@ -103,7 +103,7 @@ func (c *Client) GetPayment(ctx context.Context, paymentID int) (*Payment, error
 
 // CreatePayment creates a Payment
 func (c *Client) CreatePayment(ctx context.Context, opts PaymentCreateOptions) (*Payment, error) {
-	body, err := json.Marshal(opts)
+	body, err := c.marshalJSON(opts)
 	if err != nil {
 		return nil, err
 	}
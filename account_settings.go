@@ -2,7 +2,7 @@ package linodego
 
 import (
 	"context"
-	"encoding/json"
+	"fmt"
 )
 
 // AccountSettings are the account wide flags or plans that effect new resources
@@ -21,6 +21,40 @@ type AccountSettings struct {
 
 	// A string like "disabled", "suspended", or "active" describing the status of this account’s Object Storage service enrollment.
 	ObjectStorage *string `json:"object_storage"`
+
+	// The Firewalls assigned by default to new resources on the account.
+	DefaultFirewallIDs *DefaultFirewallIDs `json:"default_firewall_ids"`
+}
+
+// DefaultFirewallIDs are the IDs of the Firewalls assigned by default to new resources of each kind,
+// nil if no default Firewall is set for the kind of resource.
+type DefaultFirewallIDs struct {
+	Linode          *int `json:"linode"`
+	PublicInterface *int `json:"public_interface"`
+	VPCInterface    *int `json:"vpc_interface"`
+	NodeBalancer    *int `json:"nodebalancer"`
+}
+
+// DefaultFirewallIDsUpdateOptions are the default Firewalls to update. A nil field leaves the default
+// of the kind of resource unchanged, while a NullableInt that is not Valid clears it.
+type DefaultFirewallIDsUpdateOptions struct {
+	Linode          *NullableInt `json:"linode,omitempty"`
+	PublicInterface *NullableInt `json:"public_interface,omitempty"`
+	VPCInterface    *NullableInt `json:"vpc_interface,omitempty"`
+	NodeBalancer    *NullableInt `json:"nodebalancer,omitempty"`
+}
+
+// firewallIDs returns the default Firewall IDs which are set
+func (ids DefaultFirewallIDsUpdateOptions) firewallIDs() []int {
+	var result []int
+
+	for _, id := range []*NullableInt{ids.Linode, ids.PublicInterface, ids.VPCInterface, ids.NodeBalancer} {
+		if id != nil && id.Valid {
+			result = append(result, id.Value)
+		}
+	}
+
+	return result
 }
 
 // AccountSettingsUpdateOptions are the updateable account wide flags or plans that effect new resources.
@@ -34,6 +68,9 @@ type AccountSettingsUpdateOptions struct {
 
 	// The default network helper setting for all new Linodes and Linode Configs for all users on the account.
 	NetworkHelper *bool `json:"network_helper,omitempty"`
+
+	// The Firewalls to assign by default to new resources on the account.
+	DefaultFirewallIDs *DefaultFirewallIDsUpdateOptions `json:"default_firewall_ids,omitempty"`
 }
 
 // GetAccountSettings gets the account wide flags or plans that effect new resources
@@ -48,9 +85,18 @@ func (c *Client) GetAccountSettings(ctx context.Context) (*AccountSettings, erro
 	return r.Result().(*AccountSettings), nil
 }
 
-// UpdateAccountSettings updates the settings associated with the account
+// UpdateAccountSettings updates the settings associated with the account.
+// The default Firewalls, if any, are checked to exist before the settings are updated.
 func (c *Client) UpdateAccountSettings(ctx context.Context, opts AccountSettingsUpdateOptions) (*AccountSettings, error) {
-	body, err := json.Marshal(opts)
+	if opts.DefaultFirewallIDs != nil {
+		for _, firewallID := range opts.DefaultFirewallIDs.firewallIDs() {
+			if _, err := c.GetFirewall(ctx, firewallID); err != nil {
+				return nil, fmt.Errorf("failed to get default firewall %d: %w", firewallID, err)
+			}
+		}
+	}
+
+	body, err := c.marshalJSON(opts)
 	if err != nil {
 		return nil, err
 	}
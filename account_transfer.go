@@ -31,3 +31,25 @@ func (c *Client) GetAccountTransfer(ctx context.Context) (*AccountTransfer, erro
 
 	return r.Result().(*AccountTransfer), nil
 }
+
+// ByRegion returns the network utilization of the Account in each region, keyed by region ID.
+func (t AccountTransfer) ByRegion() map[string]AccountTransferRegion {
+	regions := make(map[string]AccountTransferRegion, len(t.RegionTransfers))
+	for _, region := range t.RegionTransfers {
+		regions[region.ID] = region
+	}
+
+	return regions
+}
+
+// GetAccountTransferByRegion gets current Account's network utilization for the current month
+// in each region, keyed by region ID. Transfer is pooled per region, so one region may be
+// billable while others are still under their quota.
+func (c *Client) GetAccountTransferByRegion(ctx context.Context) (map[string]AccountTransferRegion, error) {
+	transfer, err := c.GetAccountTransfer(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return transfer.ByRegion(), nil
+}
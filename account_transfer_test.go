@@ -0,0 +1,26 @@
+package linodego
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestClient_GetAccountTransferByRegion(t *testing.T) {
+	ts, client := createTestServer(http.MethodGet, "/v4/account/transfer", "application/json", `{
+		"billable": 12, "quota": 9000, "used": 2012,
+		"region_transfers": [
+			{"id": "us-east", "billable": 0, "quota": 8000, "used": 1000},
+			{"id": "id-cgk", "billable": 12, "quota": 1000, "used": 1012}
+		]}`, http.StatusOK)
+	defer ts.Close()
+
+	regions, err := client.GetAccountTransferByRegion(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(regions) != 2 || regions["us-east"].Quota != 8000 || regions["id-cgk"].Billable != 12 {
+		t.Errorf("unexpected region transfers %+v", regions)
+	}
+}
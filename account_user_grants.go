@@ -2,18 +2,21 @@ package linodego
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"net/url"
 )
 
+// GrantPermissionLevel is the level of access a user is granted to an entity or the account
 type GrantPermissionLevel string
 
+// GrantPermissionLevel constants are the levels of access accepted by the API
 const (
 	AccessLevelReadOnly  GrantPermissionLevel = "read_only"
 	AccessLevelReadWrite GrantPermissionLevel = "read_write"
 )
 
+// GlobalUserGrants are the grants of a user that are not specific to an entity.
+// A nil AccountAccess grants no access to the account.
 type GlobalUserGrants struct {
 	AccountAccess        *GrantPermissionLevel `json:"account_access"`
 	AddDatabases         bool                  `json:"add_databases"`
@@ -25,21 +28,26 @@ type GlobalUserGrants struct {
 	AddNodeBalancers     bool                  `json:"add_nodebalancers"`
 	AddStackScripts      bool                  `json:"add_stackscripts"`
 	AddVolumes           bool                  `json:"add_volumes"`
+	AddVPCs              bool                  `json:"add_vpcs"`
 	CancelAccount        bool                  `json:"cancel_account"`
 	LongviewSubscription bool                  `json:"longview_subscription"`
 }
 
+// EntityUserGrant is the grant of a user to the entity with the given ID.
+// A nil Permissions revokes the access of the user to the entity.
 type EntityUserGrant struct {
 	ID          int                   `json:"id"`
 	Permissions *GrantPermissionLevel `json:"permissions"`
 }
 
+// GrantedEntity is an entity and the access a user is granted to it
 type GrantedEntity struct {
 	ID          int                  `json:"id"`
 	Label       string               `json:"label"`
 	Permissions GrantPermissionLevel `json:"permissions"`
 }
 
+// UserGrants are the grants of a user, by entity type
 type UserGrants struct {
 	Database     []GrantedEntity `json:"database"`
 	Domain       []GrantedEntity `json:"domain"`
@@ -50,10 +58,13 @@ type UserGrants struct {
 	NodeBalancer []GrantedEntity `json:"nodebalancer"`
 	StackScript  []GrantedEntity `json:"stackscript"`
 	Volume       []GrantedEntity `json:"volume"`
+	VPC          []GrantedEntity `json:"vpc"`
 
 	Global GlobalUserGrants `json:"global"`
 }
 
+// UserGrantsUpdateOptions fields are those accepted by UpdateUserGrants.
+// Entity types that are omitted keep their current grants.
 type UserGrantsUpdateOptions struct {
 	Database     []GrantedEntity   `json:"database,omitempty"`
 	Domain       []EntityUserGrant `json:"domain,omitempty"`
@@ -64,10 +75,48 @@ type UserGrantsUpdateOptions struct {
 	NodeBalancer []EntityUserGrant `json:"nodebalancer,omitempty"`
 	StackScript  []EntityUserGrant `json:"stackscript,omitempty"`
 	Volume       []EntityUserGrant `json:"volume,omitempty"`
+	VPC          []EntityUserGrant `json:"vpc,omitempty"`
 
 	Global GlobalUserGrants `json:"global"`
 }
 
+// GetUpdateOptions converts UserGrants to UserGrantsUpdateOptions for use in UpdateUserGrants
+func (g UserGrants) GetUpdateOptions() UserGrantsUpdateOptions {
+	return UserGrantsUpdateOptions{
+		Database:     g.Database,
+		Domain:       entityUserGrants(g.Domain),
+		Firewall:     entityUserGrants(g.Firewall),
+		Image:        entityUserGrants(g.Image),
+		Linode:       entityUserGrants(g.Linode),
+		Longview:     entityUserGrants(g.Longview),
+		NodeBalancer: entityUserGrants(g.NodeBalancer),
+		StackScript:  entityUserGrants(g.StackScript),
+		Volume:       entityUserGrants(g.Volume),
+		VPC:          entityUserGrants(g.VPC),
+		Global:       g.Global,
+	}
+}
+
+func entityUserGrants(entities []GrantedEntity) []EntityUserGrant {
+	if entities == nil {
+		return nil
+	}
+
+	grants := make([]EntityUserGrant, len(entities))
+
+	for i, entity := range entities {
+		grants[i] = EntityUserGrant{ID: entity.ID}
+
+		if entity.Permissions != "" {
+			permissions := entity.Permissions
+			grants[i].Permissions = &permissions
+		}
+	}
+
+	return grants
+}
+
+// GetUserGrants gets the grants of the user with the provided username
 func (c *Client) GetUserGrants(ctx context.Context, username string) (*UserGrants, error) {
 	username = url.PathEscape(username)
 	e := fmt.Sprintf("account/users/%s/grants", username)
@@ -80,8 +129,9 @@ func (c *Client) GetUserGrants(ctx context.Context, username string) (*UserGrant
 	return r.Result().(*UserGrants), nil
 }
 
+// UpdateUserGrants updates the grants of the user with the provided username
 func (c *Client) UpdateUserGrants(ctx context.Context, username string, opts UserGrantsUpdateOptions) (*UserGrants, error) {
-	body, err := json.Marshal(opts)
+	body, err := c.marshalJSON(opts)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,59 @@
+package linodego
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestClient_UpdateUserGrants_fromUserGrants(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Set("Content-Type", "application/json")
+
+		switch r.Method {
+		case http.MethodGet:
+			rw.Write([]byte(`{
+				"linode": [{"id": 123, "label": "web", "permissions": "read_only"}, {"id": 456, "label": "db", "permissions": null}],
+				"global": {"account_access": null, "add_linodes": false}
+			}`))
+		case http.MethodPut:
+			var body map[string]json.RawMessage
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Fatal(err)
+			}
+
+			if got := string(body["linode"]); got != `[{"id":123,"permissions":"read_write"},{"id":456,"permissions":null}]` {
+				t.Errorf("unexpected linode grants %s", got)
+			}
+
+			if _, ok := body["volume"]; ok {
+				t.Error("expected volume grants to be omitted")
+			}
+
+			rw.Write([]byte(`{"linode": [{"id": 123, "label": "web", "permissions": "read_write"}], "global": {"add_linodes": true}}`))
+		}
+	}))
+	defer ts.Close()
+
+	client := NewClient(nil)
+	client.SetBaseURL(ts.URL)
+
+	grants, err := client.GetUserGrants(context.Background(), "example")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	grants.Linode[0].Permissions = AccessLevelReadWrite
+	grants.Global.AddLinodes = true
+
+	updated, err := client.UpdateUserGrants(context.Background(), "example", grants.GetUpdateOptions())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !updated.Global.AddLinodes || updated.Linode[0].Permissions != AccessLevelReadWrite {
+		t.Errorf("unexpected grants %v", updated)
+	}
+}
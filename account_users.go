@@ -120,7 +120,7 @@ func (c *Client) GetUser(ctx context.Context, userID string) (*User, error) {
 // CreateUser creates a User.  The email address must be confirmed before the
 // User account can be accessed.
 func (c *Client) CreateUser(ctx context.Context, opts UserCreateOptions) (*User, error) {
-	body, err := json.Marshal(opts)
+	body, err := c.marshalJSON(opts)
 	if err != nil {
 		return nil, err
 	}
@@ -137,7 +137,7 @@ func (c *Client) CreateUser(ctx context.Context, opts UserCreateOptions) (*User,
 
 // UpdateUser updates the User with the specified id
 func (c *Client) UpdateUser(ctx context.Context, userID string, opts UserUpdateOptions) (*User, error) {
-	body, err := json.Marshal(opts)
+	body, err := c.marshalJSON(opts)
 	if err != nil {
 		return nil, err
 	}
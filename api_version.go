@@ -0,0 +1,60 @@
+package linodego
+
+import (
+	"context"
+	"strings"
+
+	"github.com/go-resty/resty/v2"
+)
+
+type apiVersionContextKey struct{}
+
+// WithAPIVersion returns a copy of ctx which sends any request made with it to the given
+// version of the API, e.g. "v4beta", while the Client keeps its own version for other requests.
+// This takes precedence over SetAPIVersion. Requests made with an invalid version fail.
+// Cached responses are shared between API versions, see UseCache.
+func WithAPIVersion(ctx context.Context, apiVersion string) context.Context {
+	return context.WithValue(ctx, apiVersionContextKey{}, apiVersion)
+}
+
+// applyContextAPIVersion is a resty middleware which sends the request to the API version
+// set with WithAPIVersion, if any, by resolving its URL against a versioned base URL.
+func applyContextAPIVersion(rc *resty.Client, r *resty.Request) error {
+	apiVersion, ok := r.Context().Value(apiVersionContextKey{}).(string)
+	if !ok || apiVersion == "" {
+		return nil
+	}
+
+	// The URL is absolute when the request is retried or was not made for the Linode API
+	if strings.HasPrefix(r.URL, "http://") || strings.HasPrefix(r.URL, "https://") {
+		return nil
+	}
+
+	if err := validateAPIVersion(apiVersion); err != nil {
+		return err
+	}
+
+	r.URL = apiBaseURL(rc.BaseURL) + apiVersion + "/" + strings.TrimPrefix(r.URL, "/")
+
+	return nil
+}
+
+// apiBaseURL returns the base URL of the Client without the API version, ending with a slash
+func apiBaseURL(baseURL string) string {
+	return baseURL[:strings.LastIndex(baseURL, "/")+1]
+}
+
+// requestEndpoint returns the endpoint of a request URL without the base URL and API version,
+// e.g. "linode/instances/123", regardless of the API version it was sent to.
+func requestEndpoint(baseURL, requestURL string) string {
+	endpoint, _, _ := strings.Cut(requestURL, "?")
+
+	prefix := apiBaseURL(baseURL)
+	if !strings.HasPrefix(endpoint, prefix) {
+		return endpoint
+	}
+
+	_, endpoint, _ = strings.Cut(strings.TrimPrefix(endpoint, prefix), "/")
+
+	return endpoint
+}
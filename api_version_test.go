@@ -0,0 +1,68 @@
+package linodego
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestWithAPIVersion(t *testing.T) {
+	var paths []string
+
+	ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+
+		if len(paths) == 2 {
+			rw.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(`{"id": 123}`))
+	}))
+	defer ts.Close()
+
+	client := NewClient(nil)
+	client.SetBaseURL(ts.URL)
+	client.SetRetryWaitTime(time.Millisecond)
+
+	if _, err := client.GetInstance(context.Background(), 123); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := client.GetInstance(WithAPIVersion(context.Background(), "v4beta"), 123); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{"/v4/linode/instances/123", "/v4beta/linode/instances/123", "/v4beta/linode/instances/123"}
+	if len(paths) != len(expected) {
+		t.Fatalf("expected requests to %v, got %v", expected, paths)
+	}
+
+	for i, path := range expected {
+		if paths[i] != path {
+			t.Errorf("expected request %d to %s, got %s", i, path, paths[i])
+		}
+	}
+
+	if _, err := client.GetInstance(WithAPIVersion(context.Background(), "v4/beta"), 123); err == nil {
+		t.Error("expected an error for an invalid API version")
+	}
+}
+
+func TestRequestEndpoint(t *testing.T) {
+	for _, tc := range []struct {
+		requestURL string
+		expected   string
+	}{
+		{"https://api.linode.com/v4/linode/instances/123", "linode/instances/123"},
+		{"https://api.linode.com/v4beta/linode/instances?page=1", "linode/instances"},
+		{"https://example.com/upload", "https://example.com/upload"},
+	} {
+		if endpoint := requestEndpoint("https://api.linode.com/v4", tc.requestURL); endpoint != tc.expected {
+			t.Errorf("expected endpoint %s for %s, got %s", tc.expected, tc.requestURL, endpoint)
+		}
+	}
+}
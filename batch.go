@@ -0,0 +1,158 @@
+package linodego
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// DefaultBatchConcurrency is the number of concurrent requests made by batch
+// operations when BatchOptions.Concurrency is not set.
+const DefaultBatchConcurrency = 4
+
+// BatchOptions are the options accepted by batch operations such as DeleteInstances
+type BatchOptions struct {
+	// Concurrency is the maximum number of requests made concurrently.
+	// Defaults to DefaultBatchConcurrency.
+	Concurrency int
+
+	// StopOnFirstError stops making new requests after the first failure.
+	// Requests that are already in progress are cancelled, and the IDs that
+	// were not attempted are reported in BatchError.SkippedIDs.
+	StopOnFirstError bool
+}
+
+// BatchError is returned by batch operations when one or more requests fail.
+// Errors contains an error for each ID in FailedIDs, in the same order.
+// SkippedIDs are the IDs that were not attempted because of BatchOptions.StopOnFirstError.
+type BatchError struct {
+	FailedIDs  []int
+	Errors     []error
+	SkippedIDs []int
+}
+
+func (e *BatchError) Error() string {
+	messages := make([]string, len(e.Errors))
+	for i, err := range e.Errors {
+		messages[i] = err.Error()
+	}
+
+	msg := fmt.Sprintf("%d batch operations failed: %s", len(e.Errors), strings.Join(messages, "; "))
+	if len(e.SkippedIDs) > 0 {
+		msg += fmt.Sprintf(" (%d skipped)", len(e.SkippedIDs))
+	}
+
+	return msg
+}
+
+// Unwrap returns the errors of the failed operations, allowing them to be
+// inspected with errors.Is and errors.As.
+func (e *BatchError) Unwrap() []error {
+	return e.Errors
+}
+
+// DeleteInstances deletes the Instances with the given IDs, returning a *BatchError
+// if any of them could not be deleted.
+func (c *Client) DeleteInstances(ctx context.Context, ids []int, opts *BatchOptions) error {
+	return batchDelete(ctx, "Instance", ids, opts, c.DeleteInstance)
+}
+
+// DeleteVolumes deletes the Volumes with the given IDs, returning a *BatchError
+// if any of them could not be deleted.
+func (c *Client) DeleteVolumes(ctx context.Context, ids []int, opts *BatchOptions) error {
+	return batchDelete(ctx, "Volume", ids, opts, c.DeleteVolume)
+}
+
+// DeleteNodeBalancers deletes the NodeBalancers with the given IDs, returning a *BatchError
+// if any of them could not be deleted.
+func (c *Client) DeleteNodeBalancers(ctx context.Context, ids []int, opts *BatchOptions) error {
+	return batchDelete(ctx, "NodeBalancer", ids, opts, c.DeleteNodeBalancer)
+}
+
+// batchDelete calls del for each of the given IDs with bounded concurrency, see batchDo.
+func batchDelete(ctx context.Context, resource string, ids []int, opts *BatchOptions, del func(context.Context, int) error) error {
+	errs, skipped := batchDo(ctx, len(ids), opts, func(ctx context.Context, i int) error {
+		return del(ctx, ids[i])
+	})
+
+	var batchErr BatchError
+
+	for i, err := range errs {
+		if skipped[i] {
+			batchErr.SkippedIDs = append(batchErr.SkippedIDs, ids[i])
+		} else if err != nil {
+			batchErr.FailedIDs = append(batchErr.FailedIDs, ids[i])
+			batchErr.Errors = append(batchErr.Errors, fmt.Errorf("failed to delete %s %d: %w", resource, ids[i], err))
+		}
+	}
+
+	if len(batchErr.Errors) > 0 || len(batchErr.SkippedIDs) > 0 {
+		return &batchErr
+	}
+
+	return nil
+}
+
+// batchDo calls fn for each index up to count with bounded concurrency and returns the error of each call.
+// Unless opts.StopOnFirstError is set, a failure does not prevent the remaining calls from being made.
+// Calls that were never made because parentCtx is done return its error, while those that were never
+// made because of StopOnFirstError are reported as skipped instead.
+func batchDo(
+	parentCtx context.Context, count int, opts *BatchOptions, fn func(ctx context.Context, index int) error,
+) (errs []error, skipped []bool) {
+	if opts == nil {
+		opts = &BatchOptions{}
+	}
+
+	concurrency := opts.Concurrency
+	if concurrency < 1 {
+		concurrency = DefaultBatchConcurrency
+	}
+
+	ctx, cancel := context.WithCancel(parentCtx)
+	defer cancel()
+
+	errs = make([]error, count)
+	skipped = make([]bool, count)
+	sem := make(chan struct{}, concurrency)
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < count; i++ {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+		}
+
+		if ctx.Err() != nil {
+			// Only the calls that were never made because we stopped on purpose are skipped
+			if parentCtx.Err() != nil {
+				errs[i] = parentCtx.Err()
+			} else {
+				skipped[i] = true
+			}
+
+			continue
+		}
+
+		wg.Add(1)
+
+		go func(i int) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			if err := fn(ctx, i); err != nil {
+				errs[i] = err
+
+				if opts.StopOnFirstError {
+					cancel()
+				}
+			}
+		}(i)
+	}
+
+	wg.Wait()
+
+	return errs, skipped
+}
@@ -0,0 +1,92 @@
+package linodego
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+func TestDeleteInstances(t *testing.T) {
+	var requests int64
+
+	ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		atomic.AddInt64(&requests, 1)
+
+		rw.Header().Set("Content-Type", "application/json")
+
+		if r.Method != http.MethodDelete {
+			rw.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		if strings.HasSuffix(r.URL.Path, "/2") || strings.HasSuffix(r.URL.Path, "/4") {
+			rw.WriteHeader(http.StatusNotFound)
+			rw.Write([]byte(`{"errors": [{"reason": "Not found"}]}`))
+
+			return
+		}
+
+		rw.Write([]byte(`{}`))
+	}))
+	defer ts.Close()
+
+	client := NewClient(nil)
+	client.SetBaseURL(ts.URL)
+
+	err := client.DeleteInstances(context.Background(), []int{1, 2, 3, 4, 5}, &BatchOptions{Concurrency: 2})
+
+	var batchErr *BatchError
+	if !errors.As(err, &batchErr) {
+		t.Fatalf("expected a BatchError, got %v", err)
+	}
+
+	if !reflect.DeepEqual(batchErr.FailedIDs, []int{2, 4}) {
+		t.Errorf("expected IDs 2 and 4 to fail, got %v", batchErr.FailedIDs)
+	}
+
+	if !errors.Is(err, ErrNotFound) {
+		t.Error("expected the batch error to unwrap to the not found errors")
+	}
+
+	if requests != 5 {
+		t.Errorf("expected all 5 instances to be deleted despite failures, got %d requests", requests)
+	}
+
+	atomic.StoreInt64(&requests, 0)
+
+	err = client.DeleteVolumes(context.Background(), []int{2, 1, 3, 5}, &BatchOptions{Concurrency: 1, StopOnFirstError: true})
+	if !errors.As(err, &batchErr) || !reflect.DeepEqual(batchErr.FailedIDs, []int{2}) {
+		t.Fatalf("expected only volume 2 to fail, got %v", err)
+	}
+
+	if requests != 1 {
+		t.Errorf("expected no requests after the first error, got %d requests", requests)
+	}
+
+	if !reflect.DeepEqual(batchErr.SkippedIDs, []int{1, 3, 5}) {
+		t.Errorf("expected volumes 1, 3 and 5 to be skipped, got %v", batchErr.SkippedIDs)
+	}
+
+	atomic.StoreInt64(&requests, 0)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err = client.DeleteVolumes(ctx, []int{1, 3}, &BatchOptions{StopOnFirstError: true})
+	if !errors.As(err, &batchErr) || !reflect.DeepEqual(batchErr.FailedIDs, []int{1, 3}) || len(batchErr.SkippedIDs) != 0 {
+		t.Fatalf("expected both volumes to fail with a cancelled context, got %v", err)
+	}
+
+	if !errors.Is(err, context.Canceled) || requests != 0 {
+		t.Errorf("expected context canceled errors and no requests, got %v after %d requests", err, requests)
+	}
+
+	if err := client.DeleteNodeBalancers(context.Background(), []int{1, 3}, nil); err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+}
@@ -2,6 +2,7 @@ package linodego
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"net/http"
@@ -10,8 +11,11 @@ import (
 	"path"
 	"path/filepath"
 	"reflect"
+	"regexp"
 	"strconv"
+	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/go-resty/resty/v2"
@@ -40,19 +44,63 @@ const (
 	APISecondsPerPoll = 3
 	// Maximum wait time for retries
 	APIRetryMaxWaitTime = time.Duration(30) * time.Second
+	// APIRetryCount is the default number of retry attempts before aborting a request.
+	// This is intentionally high so requests are effectively retried until the context expires.
+	APIRetryCount = 1000
 )
 
 var envDebug = false
 
+// apiVersionRegexp matches API versions such as "v4" and "v4beta"
+var apiVersionRegexp = regexp.MustCompile(`^v[0-9]+[A-Za-z0-9_]*$`)
+
 // Client is a wrapper around the Resty client
 type Client struct {
 	resty             *resty.Client
 	userAgent         string
 	debug             bool
-	retryConditionals []RetryConditional
+	skipValidation    bool
+	retryConditionals *retryConditionals
+	retryBackoff      RetryBackoff
+	retryCount        int
+	retryMaxWaitTime  time.Duration
+	logger            *clientLogger
+	redactor          *logRedactor
+
+	// retryConnectionFailures and retryNonIdempotentConnectionFailures are shared between
+	// copies of the Client so they can be toggled after the retry conditions are registered.
+	retryConnectionFailures              *atomic.Bool
+	retryNonIdempotentConnectionFailures *atomic.Bool
 
 	pollInterval time.Duration
 
+	pageFetchConcurrency int
+
+	rateLimiter        *rateLimiter
+	concurrencyLimiter *concurrencyLimiter
+	etags              *etagStore
+
+	defaultTimeout *atomic.Int64
+
+	requestIDGenerator *atomic.Pointer[func() string]
+
+	dryRun *atomic.Bool
+
+	tokenSource *clientTokenSource
+
+	lastResponse *lastResponse
+
+	objectStorageEndpoints *objectStorageEndpointCache
+
+	monitorAPIURL string
+	monitorTokens *monitorTokenCache
+
+	jsonMarshaler   JSONMarshaler
+	jsonUnmarshaler JSONUnmarshaler
+	strictDecoding  bool
+
+	closer *clientCloser
+
 	baseURL         string
 	apiVersion      string
 	apiProto        string
@@ -68,6 +116,12 @@ type Client struct {
 	cachedEntryLock *sync.RWMutex
 }
 
+// lastResponse holds the most recent HTTP response received by a Client and its copies.
+type lastResponse struct {
+	mu       sync.RWMutex
+	response *http.Response
+}
+
 type EnvDefaults struct {
 	Token   string
 	Profile string
@@ -82,8 +136,9 @@ type clientCacheEntry struct {
 }
 
 type (
-	Request = resty.Request
-	Logger  = resty.Logger
+	Request  = resty.Request
+	Response = resty.Response
+	Logger   = resty.Logger
 )
 
 func init() {
@@ -106,6 +161,23 @@ func (c *Client) SetUserAgent(ua string) *Client {
 	return c
 }
 
+// AppendUserAgent adds a component identifying the caller, e.g. "my-operator/1.2", to the
+// user-agent for HTTP requests. Components are placed ahead of the current user-agent, so
+// the default becomes "my-operator/1.2 linodego/x.y.z ...", and libraries built on top of
+// linodego can each add their own. Empty components are ignored.
+func (c *Client) AppendUserAgent(component string) *Client {
+	component = strings.TrimSpace(component)
+	if component == "" {
+		return c
+	}
+
+	if c.userAgent == "" {
+		return c.SetUserAgent(component)
+	}
+
+	return c.SetUserAgent(component + " " + c.userAgent)
+}
+
 // R wraps resty's R method
 func (c *Client) R(ctx context.Context) *resty.Request {
 	return c.resty.R().
@@ -115,7 +187,9 @@ func (c *Client) R(ctx context.Context) *resty.Request {
 		SetError(APIError{})
 }
 
-// SetDebug sets the debug on resty's client
+// SetDebug sets the debug on resty's client, which logs the method, URL, headers and body
+// of every request and response through the Logger set with SetLogger. The values of secret
+// headers and fields are redacted, see AddDebugRedactionKeys.
 func (c *Client) SetDebug(debug bool) *Client {
 	c.debug = debug
 	c.resty.SetDebug(debug)
@@ -123,22 +197,81 @@ func (c *Client) SetDebug(debug bool) *Client {
 	return c
 }
 
-// SetLogger allows the user to override the output
-// logger for debug logs.
+// SetClientValidation sets whether options are checked locally before they are sent,
+// e.g. by CreateInstance using InstanceCreateOptions.Validate. Validation is enabled by default.
+func (c *Client) SetClientValidation(enabled bool) *Client {
+	c.skipValidation = !enabled
+
+	return c
+}
+
+// SetLogger allows the user to override the output logger for debug
+// and retry logs. By default, output is written to the standard library logger.
+// A nil logger silences all output.
 func (c *Client) SetLogger(logger Logger) *Client {
-	c.resty.SetLogger(logger)
+	c.logger.setLogger(logger)
 
 	return c
 }
 
 // OnBeforeRequest adds a handler to the request body to run before the request is sent
+// Handlers run in the order they were added. If a handler returns an error,
+// the request is not sent and the error is returned to the caller.
 func (c *Client) OnBeforeRequest(m func(request *Request) error) {
 	c.resty.OnBeforeRequest(func(client *resty.Client, req *resty.Request) error {
 		return m(req)
 	})
 }
 
-// SetBaseURL sets the base URL of the Linode v4 API (https://api.linode.com/v4)
+// OnAfterResponse adds a handler to run after a response has been received.
+// The underlying *http.Response is available through response.RawResponse.
+// Handlers run in the order they were added. If a handler returns an error,
+// the error is returned to the caller.
+func (c *Client) OnAfterResponse(m func(response *Response) error) {
+	c.resty.OnAfterResponse(func(client *resty.Client, resp *resty.Response) error {
+		return m(resp)
+	})
+}
+
+// OnRequestFinished adds a handler to run once a request has completed, after all
+// retry attempts have been made. response is nil if no response was received.
+// err is set if the request could not be completed, it does not reflect the
+// HTTP status of the response.
+func (c *Client) OnRequestFinished(m func(request *Request, response *Response, err error)) {
+	c.resty.OnSuccess(func(client *resty.Client, resp *resty.Response) {
+		m(resp.Request, resp, nil)
+	})
+
+	c.resty.OnError(func(req *resty.Request, err error) {
+		var resp *resty.Response
+
+		var responseErr *resty.ResponseError
+		if errors.As(err, &responseErr) {
+			resp = responseErr.Response
+			err = responseErr.Err
+		}
+
+		m(req, resp, err)
+	})
+}
+
+// LastResponse returns the most recent HTTP response received by the client, including
+// responses of attempts that were retried, or nil if no response has been received.
+// This allows inspecting the status code and headers, such as Retry-After or
+// X-Maintenance-Mode, alongside the result of a typed method. The body of the
+// response has already been read and closed.
+// When the client is used concurrently, the response may belong to another request;
+// use OnAfterResponse to inspect the responses of every request instead.
+func (c *Client) LastResponse() *http.Response {
+	c.lastResponse.mu.RLock()
+	defer c.lastResponse.mu.RUnlock()
+
+	return c.lastResponse.response
+}
+
+// SetBaseURL sets the base URL of the Linode v4 API (https://api.linode.com/v4), without the API version,
+// e.g. "https://api.linode.com" or the URL of a staging or mock endpoint.
+// Changing the base URL clears the cached responses, which are keyed by path.
 func (c *Client) SetBaseURL(baseURL string) *Client {
 	baseURLPath, _ := url.Parse(baseURL)
 
@@ -150,8 +283,16 @@ func (c *Client) SetBaseURL(baseURL string) *Client {
 	return c
 }
 
-// SetAPIVersion sets the version of the API to interface with
+// SetAPIVersion sets the version of the API to interface with, e.g. "v4" or "v4beta".
+// An empty version resets the client to the default APIVersion. Invalid versions are logged
+// as an error and ignored. Changing the API version clears the cached responses, which are keyed by path.
+// Use WithAPIVersion to send individual requests to a different version.
 func (c *Client) SetAPIVersion(apiVersion string) *Client {
+	if err := validateAPIVersion(apiVersion); err != nil {
+		c.logger.Errorf("%s, using API version %s", err, c.getAPIVersion())
+		return c
+	}
+
 	c.apiVersion = apiVersion
 
 	c.updateHostURL()
@@ -159,31 +300,52 @@ func (c *Client) SetAPIVersion(apiVersion string) *Client {
 	return c
 }
 
+// validateAPIVersion checks that the API version can be used as the path segment
+// of the base URL, e.g. "v4" or "v4beta". Empty versions are valid.
+func validateAPIVersion(apiVersion string) error {
+	if apiVersion != "" && !apiVersionRegexp.MatchString(apiVersion) {
+		return fmt.Errorf("invalid API version %q, must be e.g. %q or %q", apiVersion, "v4", "v4beta")
+	}
+
+	return nil
+}
+
+func (c *Client) getAPIVersion() string {
+	if c.apiVersion != "" {
+		return c.apiVersion
+	}
+
+	return APIVersion
+}
+
 func (c *Client) updateHostURL() {
 	apiProto := APIProto
 	baseURL := APIHost
-	apiVersion := APIVersion
 
 	if c.baseURL != "" {
 		baseURL = c.baseURL
 	}
 
-	if c.apiVersion != "" {
-		apiVersion = c.apiVersion
-	}
-
 	if c.apiProto != "" {
 		apiProto = c.apiProto
 	}
 
-	c.resty.SetBaseURL(
-		fmt.Sprintf(
-			"%s://%s/%s",
-			apiProto,
-			baseURL,
-			url.PathEscape(apiVersion),
-		),
+	hostURL := fmt.Sprintf(
+		"%s://%s/%s",
+		apiProto,
+		baseURL,
+		url.PathEscape(c.getAPIVersion()),
 	)
+
+	if hostURL == c.resty.BaseURL {
+		return
+	}
+
+	c.resty.SetBaseURL(hostURL)
+
+	if c.cachedEntryLock != nil {
+		c.InvalidateCache()
+	}
 }
 
 // SetRootCertificate adds a root certificate to the underlying TLS client config
@@ -192,8 +354,38 @@ func (c *Client) SetRootCertificate(path string) *Client {
 	return c
 }
 
+// SetTransport sets the http.RoundTripper used to send requests, e.g. an *http.Transport
+// with a custom TLS config or connection pool settings. Headers, retries and rate limiting
+// of the Client still apply to every request sent through the transport. SetRootCertificate
+// and SetProxy only take effect when the transport is an *http.Transport, so they should be
+// called after SetTransport.
+func (c *Client) SetTransport(transport http.RoundTripper) *Client {
+	c.resty.SetTransport(transport)
+
+	if c.dryRun != nil {
+		c.wrapDryRunTransport()
+	}
+
+	return c
+}
+
+// SetProxy routes all requests through the proxy at proxyURL, e.g. "http://proxy.internal:3128".
+// An error is logged and the proxy is not set if proxyURL is invalid or the transport of the
+// Client is not an *http.Transport.
+func (c *Client) SetProxy(proxyURL string) *Client {
+	c.resty.SetProxy(proxyURL)
+	return c
+}
+
+// RemoveProxy removes the proxy set with SetProxy
+func (c *Client) RemoveProxy() *Client {
+	c.resty.RemoveProxy()
+	return c
+}
+
 // SetToken sets the API token for all requests from this client
 // Only necessary if you haven't already provided the http client to NewClient() configured with the token.
+// Use SetTokenSource for OAuth tokens that expire.
 func (c *Client) SetToken(token string) *Client {
 	c.resty.SetHeader("Authorization", fmt.Sprintf("Bearer %s", token))
 	return c
@@ -202,25 +394,69 @@ func (c *Client) SetToken(token string) *Client {
 // SetRetries adds retry conditions for "Linode Busy." errors and 429s.
 func (c *Client) SetRetries() *Client {
 	c.
-		addRetryConditional(linodeBusyRetryCondition).
-		addRetryConditional(tooManyRequestsRetryCondition).
-		addRetryConditional(serviceUnavailableRetryCondition).
-		addRetryConditional(requestTimeoutRetryCondition).
-		addRetryConditional(requestGOAWAYRetryCondition).
-		addRetryConditional(requestNGINXRetryCondition).
-		SetRetryMaxWaitTime(APIRetryMaxWaitTime)
+		addRetryConditional("linodeBusy", linodeBusyRetryCondition).
+		addRetryConditional("tooManyRequests", tooManyRequestsRetryCondition).
+		addRetryConditional("serviceUnavailable", serviceUnavailableRetryCondition).
+		addRetryConditional("requestTimeout", requestTimeoutRetryCondition).
+		addRetryConditional("requestGOAWAY", requestGOAWAYRetryCondition).
+		addRetryConditional("requestNGINX", requestNGINXRetryCondition).
+		addRetryConditional("connectionFailure", func(r *resty.Response, err error) bool {
+			return c.retryConnectionFailures.Load() &&
+				(c.retryNonIdempotentConnectionFailures.Load() || isIdempotentRequest(r)) &&
+				connectionFailureRetryCondition(r, err)
+		})
 	configureRetries(c)
 	return c
 }
 
+// SetRetryOnConnectionFailure sets whether requests that fail due to transient
+// network errors (timeouts, reset connections, unexpected EOFs) should be retried.
+// This is enabled by default for GET, HEAD, PUT and DELETE requests, see
+// SetRetryNonIdempotentOnConnectionFailure.
+func (c *Client) SetRetryOnConnectionFailure(enabled bool) *Client {
+	c.retryConnectionFailures.Store(enabled)
+	return c
+}
+
+// SetRetryNonIdempotentOnConnectionFailure sets whether POST and PATCH requests are also retried
+// on connection failures. This is disabled by default because a request that reached the API
+// before the connection failed would be repeated, e.g. creating a second billable Instance.
+func (c *Client) SetRetryNonIdempotentOnConnectionFailure(enabled bool) *Client {
+	c.retryNonIdempotentConnectionFailures.Store(enabled)
+	return c
+}
+
 // AddRetryCondition adds a RetryConditional function to the Client
+// Retry policies attached to the request context using WithRetryDisabled
+// or WithMaxRetries take precedence over the condition.
 func (c *Client) AddRetryCondition(retryCondition RetryConditional) *Client {
-	c.resty.AddRetryCondition(resty.RetryConditionFunc(retryCondition))
+	c.resty.AddRetryCondition(func(r *resty.Response, err error) bool {
+		return r != nil && retryAllowedByContext(r) && retryCondition(r, err)
+	})
+	return c
+}
+
+// OnRetry registers a callback that is invoked whenever a request is about to be
+// retried, before the retry wait is applied. The callback receives the attempt that
+// triggered the retry (starting at 1) and the name of the matching retry condition,
+// e.g. "tooManyRequests", or "unauthorized" for a request retried with a fresh token of SetTokenSource.
+// Conditions added through AddRetryCondition are reported as "custom".
+func (c *Client) OnRetry(callback RetryCallback) *Client {
+	c.resty.AddRetryHook(func(r *resty.Response, err error) {
+		attempt := 0
+		if r != nil && r.Request != nil {
+			attempt = r.Request.Attempt
+		}
+
+		// The conditionals are looked up now to include those added after the callback
+		callback(r, attempt, retryReason(c.retryConditionals.list(), r, err))
+	})
+
 	return c
 }
 
-func (c *Client) addRetryConditional(retryConditional RetryConditional) *Client {
-	c.retryConditionals = append(c.retryConditionals, retryConditional)
+func (c *Client) addRetryConditional(name string, retryConditional RetryConditional) *Client {
+	c.retryConditionals.add(namedRetryConditional{name: name, condition: retryConditional})
 	return c
 }
 
@@ -303,8 +539,10 @@ func (c *Client) InvalidateCache() {
 	c.cachedEntryLock.Lock()
 	defer c.cachedEntryLock.Unlock()
 
-	// GC will handle the old map
-	c.cachedEntries = make(map[string]clientCacheEntry)
+	// Entries are deleted in place as the map is shared between copies of the client
+	for endpoint := range c.cachedEntries {
+		delete(c.cachedEntries, endpoint)
+	}
 }
 
 // InvalidateCacheEndpoint invalidates a single cached endpoint.
@@ -317,24 +555,125 @@ func (c *Client) InvalidateCacheEndpoint(endpoint string) error {
 	c.cachedEntryLock.Lock()
 	defer c.cachedEntryLock.Unlock()
 
-	delete(c.cachedEntries, u.Path)
+	delete(c.cachedEntries, strings.TrimPrefix(u.Path, "/"))
 
 	return nil
 }
 
+// invalidateRelatedCacheEntries removes all cached responses for the given
+// endpoint, its parent collections and any of its sub-resources.
+func (c *Client) invalidateRelatedCacheEntries(endpoint string) {
+	endpoint = strings.Trim(endpoint, "/")
+
+	c.cachedEntryLock.Lock()
+	defer c.cachedEntryLock.Unlock()
+
+	for key := range c.cachedEntries {
+		// List responses are keyed by their endpoint and a hash of their options
+		keyPath, _, _ := strings.Cut(key, ":")
+
+		if isPathPrefix(keyPath, endpoint) || isPathPrefix(endpoint, keyPath) {
+			delete(c.cachedEntries, key)
+		}
+	}
+}
+
+// isPathPrefix returns whether prefix matches the leading segments of path.
+func isPathPrefix(prefix, path string) bool {
+	return path == prefix || strings.HasPrefix(path, prefix+"/")
+}
+
 // SetGlobalCacheExpiration sets the desired time for any cached response
 // to be valid for.
 func (c *Client) SetGlobalCacheExpiration(expiryTime time.Duration) {
 	c.cacheExpiration = expiryTime
 }
 
-// UseCache sets whether response caching should be used
+// SetCacheTTL sets the time cached responses are valid for, unless overridden
+// by an endpoint. Defaults to 15 minutes.
+func (c *Client) SetCacheTTL(ttl time.Duration) *Client {
+	c.SetGlobalCacheExpiration(ttl)
+	return c
+}
+
+// UseCache sets whether response caching should be used. Caching is disabled by default.
+// When enabled, successful responses of cacheable GET endpoints are stored in memory and
+// any non-GET request to a related endpoint evicts them.
 func (c *Client) UseCache(value bool) {
 	c.shouldCache = value
 }
 
+type defaultTimeoutCancelContextKey struct{}
+
+// SetDefaultTimeout sets the timeout for requests whose context has no deadline.
+// The timeout bounds the total time of a request including all retry attempts
+// and the waits between them, not the time of each attempt. A retry wait that
+// would exceed the timeout is aborted. Contexts that have a deadline are left untouched.
+// A timeout of 0 disables the default timeout, which is the default.
+func (c *Client) SetDefaultTimeout(timeout time.Duration) *Client {
+	if c.defaultTimeout == nil {
+		defaultTimeout := &atomic.Int64{}
+		c.defaultTimeout = defaultTimeout
+
+		c.OnBeforeRequest(func(request *Request) error {
+			timeout := time.Duration(defaultTimeout.Load())
+			if request.Attempt > 1 || timeout <= 0 {
+				return nil
+			}
+
+			if _, ok := request.Context().Deadline(); ok {
+				return nil
+			}
+
+			ctx, cancel := context.WithTimeout(request.Context(), timeout)
+			request.SetContext(context.WithValue(ctx, defaultTimeoutCancelContextKey{}, cancel))
+
+			return nil
+		})
+
+		c.OnRequestFinished(func(request *Request, _ *Response, _ error) {
+			if cancel, ok := request.Context().Value(defaultTimeoutCancelContextKey{}).(context.CancelFunc); ok {
+				cancel()
+			}
+		})
+	}
+
+	c.defaultTimeout.Store(int64(timeout))
+
+	return c
+}
+
+// SetRequestIDGenerator sets a function generating the X-Request-ID header sent with each request,
+// allowing requests to be correlated with the logs of the Linode API. The ID is generated once
+// per call, so retries of a request send the same ID. Requests that already have the header are
+// left untouched. The request ID is available on APIError.RequestID and included in retry logs.
+// A nil generator stops request IDs from being sent.
+func (c *Client) SetRequestIDGenerator(generator func() string) *Client {
+	if c.requestIDGenerator == nil {
+		requestIDGenerator := &atomic.Pointer[func() string]{}
+		c.requestIDGenerator = requestIDGenerator
+
+		c.OnBeforeRequest(func(request *Request) error {
+			generator := requestIDGenerator.Load()
+			if generator == nil || *generator == nil || request.Header.Get(requestIDHeaderName) != "" {
+				return nil
+			}
+
+			request.SetHeader(requestIDHeaderName, (*generator)())
+
+			return nil
+		})
+	}
+
+	c.requestIDGenerator.Store(&generator)
+
+	return c
+}
+
 // SetRetryMaxWaitTime sets the maximum delay before retrying a request.
+// Defaults to APIRetryMaxWaitTime.
 func (c *Client) SetRetryMaxWaitTime(max time.Duration) *Client {
+	c.retryMaxWaitTime = max
 	c.resty.SetRetryMaxWaitTime(max)
 	return c
 }
@@ -352,12 +691,43 @@ func (c *Client) SetRetryAfter(callback RetryAfter) *Client {
 	return c
 }
 
+// SetRetryBackoff enables exponential backoff with full jitter for retries where
+// the API did not return a Retry-After header. The wait before each retry is a random
+// duration up to min * 2^attempt, capped at max. A Retry-After header always takes precedence.
+// The minimum delay of SetRetryWaitTime is reset, as it would otherwise raise shorter waits to it.
+func (c *Client) SetRetryBackoff(min, max time.Duration) *Client {
+	c.SetRetryWaitTime(0).SetRetryMaxWaitTime(max)
+	return c.SetRetryBackoffFunc(exponentialBackoff(min, max))
+}
+
+// SetRetryBackoffFunc sets a custom function used to compute the delay before a retry
+// when the API did not return a Retry-After header. The result is bounded by the
+// values of SetRetryWaitTime and SetRetryMaxWaitTime, and a result of 0 falls back to
+// the jittered backoff of resty.
+// Passing nil restores the default behavior.
+func (c *Client) SetRetryBackoffFunc(backoff RetryBackoff) *Client {
+	c.retryBackoff = backoff
+	c.resty.SetRetryAfter(retryAfterWithBackoff(c.logger, backoff))
+	return c
+}
+
 // SetRetryCount sets the maximum retry attempts before aborting.
+// Defaults to APIRetryCount, which retries until the request context expires
+// in most practical cases. A count of 0 disables retries entirely.
 func (c *Client) SetRetryCount(count int) *Client {
+	c.retryCount = count
 	c.resty.SetRetryCount(count)
 	return c
 }
 
+// SetPageFetchConcurrency sets the maximum number of pages fetched concurrently
+// when a list call returns every page of results. The first page is always fetched
+// on its own to determine the number of pages. Values less than 2 fetch pages sequentially.
+func (c *Client) SetPageFetchConcurrency(n int) *Client {
+	c.pageFetchConcurrency = n
+	return c
+}
+
 // SetPollDelay sets the number of milliseconds to wait between events or status polls.
 // Affects all WaitFor* functions and retries.
 func (c *Client) SetPollDelay(delay time.Duration) *Client {
@@ -386,11 +756,55 @@ func NewClient(hc *http.Client) (client Client) {
 		client.resty = resty.New()
 	}
 
-	client.shouldCache = true
+	client.shouldCache = false
 	client.cacheExpiration = time.Minute * 15
 	client.cachedEntries = make(map[string]clientCacheEntry)
 	client.cachedEntryLock = &sync.RWMutex{}
 
+	client.logger = newClientLogger()
+	client.resty.SetLogger(client.logger)
+
+	client.redactor = newLogRedactor()
+	client.resty.
+		OnRequestLog(func(rl *resty.RequestLog) error {
+			client.redactor.redactHeader(rl.Header)
+			rl.Body = client.redactor.redactBody(rl.Body)
+			return nil
+		}).
+		OnResponseLog(func(rl *resty.ResponseLog) error {
+			client.redactor.redactHeader(rl.Header)
+			rl.Body = client.redactor.redactBody(rl.Body)
+			return nil
+		})
+
+	client.retryConnectionFailures = &atomic.Bool{}
+	client.retryConnectionFailures.Store(true)
+	client.retryNonIdempotentConnectionFailures = &atomic.Bool{}
+
+	client.retryConditionals = &retryConditionals{}
+	client.retryCount = APIRetryCount
+	client.retryMaxWaitTime = APIRetryMaxWaitTime
+
+	client.lastResponse = &lastResponse{}
+	client.objectStorageEndpoints = &objectStorageEndpointCache{}
+	client.monitorTokens = &monitorTokenCache{}
+	client.closer = &clientCloser{}
+
+	client.resty.OnBeforeRequest(client.closer.rejectClosed)
+	client.resty.OnBeforeRequest(applyContextAPIVersion)
+
+	client.resty.OnAfterResponse(func(rc *resty.Client, r *resty.Response) error {
+		client.lastResponse.mu.Lock()
+		client.lastResponse.response = r.RawResponse
+		client.lastResponse.mu.Unlock()
+
+		if r.Request.Method != http.MethodGet {
+			client.invalidateRelatedCacheEntries(requestEndpoint(rc.BaseURL, r.Request.URL))
+		}
+
+		return nil
+	})
+
 	client.SetUserAgent(DefaultUserAgent)
 
 	baseURL, baseURLExists := os.LookupEnv(APIHostVar)
@@ -429,9 +843,19 @@ func NewClient(hc *http.Client) (client Client) {
 	return
 }
 
+// NewClientFromRoundTripper creates a Client that sends all requests through rt,
+// e.g. a mocktest.Transport serving canned responses in unit tests.
+func NewClientFromRoundTripper(rt http.RoundTripper) Client {
+	return NewClient(&http.Client{Transport: rt})
+}
+
 // NewClientFromEnv creates a Client and initializes it with values
 // from the LINODE_CONFIG file and the LINODE_TOKEN environment variable.
 func NewClientFromEnv(hc *http.Client) (*Client, error) {
+	if err := validateAPIVersion(os.Getenv(APIVersionVar)); err != nil {
+		return nil, fmt.Errorf("%s: %w", APIVersionVar, err)
+	}
+
 	client := NewClient(hc)
 
 	// Users are expected to chain NewClient(...) and LoadConfig(...) to customize these options
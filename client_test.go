@@ -1,10 +1,18 @@
 package linodego
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/go-resty/resty/v2"
 	"github.com/google/go-cmp/cmp"
+	"golang.org/x/oauth2"
 )
 
 func TestClient_SetAPIVersion(t *testing.T) {
@@ -111,3 +119,649 @@ api_version = v4beta
 [cool]
 token = blah
 `
+
+type testLogger struct {
+	messages []string
+}
+
+func (l *testLogger) Errorf(format string, v ...any) {
+	l.messages = append(l.messages, fmt.Sprintf(format, v...))
+}
+
+func (l *testLogger) Warnf(format string, v ...any) {
+	l.messages = append(l.messages, fmt.Sprintf(format, v...))
+}
+
+func (l *testLogger) Debugf(format string, v ...any) {
+	l.messages = append(l.messages, fmt.Sprintf(format, v...))
+}
+
+func TestClient_SetLogger(t *testing.T) {
+	client := NewClient(nil)
+	logger := &testLogger{}
+
+	client.SetLogger(logger)
+	client.logger.Debugf("hello %s", "world")
+
+	if len(logger.messages) != 1 || logger.messages[0] != "hello world" {
+		t.Fatalf("expected message to be routed to the custom logger, got %v", logger.messages)
+	}
+
+	// A nil logger should silence all output without panicking
+	client.SetLogger(nil)
+	client.logger.Debugf("silenced")
+
+	if len(logger.messages) != 1 {
+		t.Fatalf("expected no additional messages, got %v", logger.messages)
+	}
+}
+
+func TestClient_RequestHooks(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Header().Set("X-Echo", r.Header.Get("X-Test"))
+		rw.Write([]byte(`{"id": 123}`))
+	}))
+	defer ts.Close()
+
+	client := NewClient(nil)
+	client.SetBaseURL(ts.URL)
+
+	var order []string
+
+	client.OnBeforeRequest(func(request *Request) error {
+		order = append(order, "before-1")
+		request.SetHeader("X-Test", "cool")
+		return nil
+	})
+
+	client.OnBeforeRequest(func(request *Request) error {
+		order = append(order, "before-2")
+		return nil
+	})
+
+	client.OnAfterResponse(func(response *Response) error {
+		order = append(order, "after")
+
+		if echo := response.RawResponse.Header.Get("X-Echo"); echo != "cool" {
+			t.Errorf("expected header to be set by the before-request hook, got %q", echo)
+		}
+
+		return nil
+	})
+
+	if _, err := client.GetInstance(context.Background(), 123); err != nil {
+		t.Fatal(err)
+	}
+
+	if diff := cmp.Diff([]string{"before-1", "before-2", "after"}, order); diff != "" {
+		t.Errorf("unexpected hook order:\n%s", diff)
+	}
+
+	client.OnBeforeRequest(func(request *Request) error {
+		return errors.New("aborted")
+	})
+
+	if _, err := client.GetInstance(context.Background(), 123); err == nil || !strings.Contains(err.Error(), "aborted") {
+		t.Errorf("expected before-request hook error to abort the request, got %v", err)
+	}
+}
+
+func TestClient_Cache(t *testing.T) {
+	var getRequests int
+
+	ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			getRequests++
+		}
+
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(`{"id": "g6-nanode-1", "label": "Nanode 1GB"}`))
+	}))
+	defer ts.Close()
+
+	client := NewClient(nil)
+	client.SetBaseURL(ts.URL)
+
+	getType := func() {
+		t.Helper()
+
+		linodeType, err := client.GetType(context.Background(), "g6-nanode-1")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if linodeType.Label != "Nanode 1GB" {
+			t.Fatalf("unexpected type label: %s", linodeType.Label)
+		}
+	}
+
+	// Caching is disabled by default
+	getType()
+	getType()
+
+	if getRequests != 2 {
+		t.Fatalf("expected 2 requests with caching disabled, got %d", getRequests)
+	}
+
+	client.UseCache(true)
+	client.SetCacheTTL(time.Hour)
+
+	getType()
+	getType()
+
+	if getRequests != 3 {
+		t.Fatalf("expected 3 requests with caching enabled, got %d", getRequests)
+	}
+
+	client.InvalidateCache()
+	getType()
+
+	if getRequests != 4 {
+		t.Fatalf("expected 4 requests after invalidation, got %d", getRequests)
+	}
+}
+
+func TestClient_Cache_writeEviction(t *testing.T) {
+	ts, client := createTestServer(http.MethodPut, "/v4/images/private/123", "application/json",
+		`{"id": "private/123", "label": "test"}`, http.StatusOK)
+	defer ts.Close()
+
+	client.UseCache(true)
+	client.addCachedResponse("images/private%2F123", Image{ID: "private/123"}, nil)
+
+	// Updating the image should evict its cached response
+	if _, err := client.UpdateImage(context.Background(), "private/123", ImageUpdateOptions{Label: "test"}); err != nil {
+		t.Fatal(err)
+	}
+
+	if client.getCachedResponse("images/private%2F123") != nil {
+		t.Error("expected the update to evict the cached response")
+	}
+}
+
+func TestClient_GetImage_notCached(t *testing.T) {
+	var getRequests int
+
+	ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		getRequests++
+
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(`{"id": "private/123", "status": "pending_upload"}`))
+	}))
+	defer ts.Close()
+
+	client := NewClient(nil)
+	client.SetBaseURL(ts.URL)
+	client.UseCache(true)
+
+	// Images change status while they are created, so waiting for them must not use the cache
+	for i := 0; i < 2; i++ {
+		if _, err := client.GetImage(context.Background(), "private/123"); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if getRequests != 2 {
+		t.Errorf("expected GetImage not to be cached, got %d requests", getRequests)
+	}
+}
+
+func TestClient_InvalidateRelatedCacheEntries(t *testing.T) {
+	client := NewClient(nil)
+	client.UseCache(true)
+
+	for _, endpoint := range []string{"images", "images:hash", "images/private/123", "images/private/1234", "regions"} {
+		client.addCachedResponse(endpoint, endpoint, nil)
+	}
+
+	client.invalidateRelatedCacheEntries("/images/private/123")
+
+	for endpoint, cached := range map[string]bool{
+		"images":              false,
+		"images:hash":         false,
+		"images/private/123":  false,
+		"images/private/1234": true,
+		"regions":             true,
+	} {
+		if (client.getCachedResponse(endpoint) != nil) != cached {
+			t.Errorf("expected cached state of %s to be %t", endpoint, cached)
+		}
+	}
+}
+
+func TestClient_SetDefaultTimeout(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Header().Set("Retry-After", "1")
+		rw.WriteHeader(http.StatusTooManyRequests)
+		rw.Write([]byte(`{"errors": [{"reason": "Too many requests"}]}`))
+	}))
+	defer ts.Close()
+
+	client := NewClient(nil)
+	client.SetBaseURL(ts.URL)
+	client.SetDefaultTimeout(50 * time.Millisecond)
+
+	start := time.Now()
+
+	if _, err := client.GetInstance(context.Background(), 123); err == nil {
+		t.Fatal("expected the request to time out")
+	}
+
+	if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+		t.Errorf("expected the default timeout to bound the retries, took %s", elapsed)
+	}
+
+	// A deadline set by the caller takes precedence
+	client.SetDefaultTimeout(time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 1500*time.Millisecond)
+	defer cancel()
+
+	client.SetRetryCount(1)
+	start = time.Now()
+
+	if _, err := client.GetInstance(ctx, 123); err == nil {
+		t.Fatal("expected the request to fail")
+	}
+
+	if elapsed := time.Since(start); elapsed < time.Second {
+		t.Errorf("expected the caller deadline to be used and the retry to be made, took %s", elapsed)
+	}
+}
+
+func TestClient_LastResponse(t *testing.T) {
+	ts, client := createTestServer(http.MethodGet, "/v4/linode/instances/123", "application/json", `{"id": 123}`, http.StatusOK)
+	defer ts.Close()
+
+	if client.LastResponse() != nil {
+		t.Fatal("expected no response before making a request")
+	}
+
+	if _, err := client.GetInstance(context.Background(), 123); err != nil {
+		t.Fatal(err)
+	}
+
+	resp := client.LastResponse()
+	if resp == nil || resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected the last response to have status 200, got %v", resp)
+	}
+
+	if resp.Header.Get("Content-Type") != "application/json" {
+		t.Errorf("expected the last response headers to be set, got %v", resp.Header)
+	}
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
+
+func TestClient_SetTransport(t *testing.T) {
+	ts, client := createTestServer(http.MethodGet, "/v4/linode/instances/123", "application/json", `{"id": 123}`, http.StatusOK)
+	defer ts.Close()
+
+	attempts := 0
+
+	client.SetToken("secret").SetRetryWaitTime(time.Millisecond).SetTransport(roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		attempts++
+
+		if r.Header.Get("Authorization") != "Bearer secret" {
+			t.Errorf("expected the client headers to be set, got %v", r.Header)
+		}
+
+		// The first attempt is retried by the client
+		if attempts == 1 {
+			return &http.Response{
+				StatusCode: http.StatusServiceUnavailable,
+				Header:     http.Header{"Retry-After": {"0"}},
+				Body:       http.NoBody,
+				Request:    r,
+			}, nil
+		}
+
+		return http.DefaultTransport.RoundTrip(r)
+	}))
+
+	if _, err := client.GetInstance(context.Background(), 123); err != nil {
+		t.Fatal(err)
+	}
+
+	if attempts != 2 {
+		t.Errorf("expected the request to be retried through the transport, got %d attempts", attempts)
+	}
+}
+
+func TestClient_SetProxy(t *testing.T) {
+	proxied := false
+
+	proxy := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		proxied = r.URL.Host == "api.invalid"
+
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(`{"id": 123}`))
+	}))
+	defer proxy.Close()
+
+	client := NewClient(nil)
+	client.SetBaseURL("http://api.invalid").SetProxy(proxy.URL)
+
+	if _, err := client.GetInstance(context.Background(), 123); err != nil {
+		t.Fatal(err)
+	}
+
+	if !proxied {
+		t.Error("expected the request to be sent through the proxy")
+	}
+}
+
+func TestClient_SetRequestIDGenerator(t *testing.T) {
+	var requestIDs []string
+
+	ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		requestIDs = append(requestIDs, r.Header.Get("X-Request-ID"))
+
+		rw.Header().Set("Content-Type", "application/json")
+
+		// The first attempt is retried by the client
+		if len(requestIDs) == 1 {
+			rw.Header().Set("Retry-After", "0")
+			rw.WriteHeader(http.StatusServiceUnavailable)
+
+			return
+		}
+
+		rw.WriteHeader(http.StatusBadRequest)
+		rw.Write([]byte(`{"errors": [{"reason": "invalid"}]}`))
+	}))
+	defer ts.Close()
+
+	generated := 0
+
+	client := NewClient(nil)
+	client.SetBaseURL(ts.URL).SetRetryWaitTime(time.Millisecond).SetRequestIDGenerator(func() string {
+		generated++
+		return fmt.Sprintf("request-%d", generated)
+	})
+
+	_, err := client.GetInstance(context.Background(), 123)
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.RequestID != "request-1" {
+		t.Fatalf("expected an API error with the request ID, got %v", err)
+	}
+
+	if len(requestIDs) != 2 || requestIDs[0] != "request-1" || requestIDs[1] != "request-1" {
+		t.Errorf("expected retries to send the same request ID, got %v", requestIDs)
+	}
+
+	if _, err := client.GetInstance(context.Background(), 123); err == nil || requestIDs[2] != "request-2" {
+		t.Errorf("expected a new request ID for the next request, got %v", requestIDs)
+	}
+}
+
+func TestAPIError_RequestIDFromResponse(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Header().Set("X-Request-ID", "server-id")
+		rw.WriteHeader(http.StatusNotFound)
+		rw.Write([]byte(`{"errors": [{"reason": "Not found"}]}`))
+	}))
+	defer ts.Close()
+
+	client := NewClient(nil)
+	client.SetBaseURL(ts.URL)
+
+	_, err := client.GetInstance(context.Background(), 123)
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.RequestID != "server-id" {
+		t.Fatalf("expected the request ID returned by the API, got %v", err)
+	}
+}
+
+type testTokenSource struct {
+	tokens []string
+	calls  int
+}
+
+func (s *testTokenSource) Token() (*oauth2.Token, error) {
+	token := s.tokens[s.calls]
+	s.calls++
+
+	return &oauth2.Token{AccessToken: token, Expiry: time.Now().Add(time.Hour)}, nil
+}
+
+func TestClient_SetTokenSource(t *testing.T) {
+	requests := 0
+
+	ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		requests++
+
+		rw.Header().Set("Content-Type", "application/json")
+
+		if r.Header.Get("Authorization") != "Bearer valid" {
+			rw.WriteHeader(http.StatusUnauthorized)
+			rw.Write([]byte(`{"errors": [{"reason": "Invalid Token"}]}`))
+			return
+		}
+
+		rw.Write([]byte(`{"username": "example"}`))
+	}))
+	defer ts.Close()
+
+	client := NewClient(nil)
+	client.SetBaseURL(ts.URL)
+	client.SetRetryWaitTime(time.Millisecond)
+	client.SetToken("static")
+
+	source := &testTokenSource{tokens: []string{"expired", "valid"}}
+	client.SetTokenSource(source)
+
+	// The rejected token is refreshed and the request retried
+	if _, err := client.GetProfile(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := client.GetProfile(context.Background()); err != nil || source.calls != 2 {
+		t.Fatalf("expected the valid token to be reused, got %v after %d tokens", err, source.calls)
+	}
+
+	// A fresh token that is rejected is returned as an error
+	source = &testTokenSource{tokens: []string{"revoked", "revoked"}}
+	client.SetTokenSource(source)
+
+	_, err := client.GetProfile(context.Background())
+	if apiErr, ok := err.(*Error); !ok || apiErr.Code != http.StatusUnauthorized {
+		t.Fatalf("expected an unauthorized error, got %v", err)
+	}
+
+	if source.calls != 2 {
+		t.Errorf("expected the token to be refreshed once, got %d tokens", source.calls)
+	}
+
+	// The static token is used once the source is removed, and is not retried
+	client.SetTokenSource(nil)
+
+	requests = 0
+
+	_, err = client.GetProfile(context.Background())
+	if apiErr, ok := err.(*Error); !ok || apiErr.Code != http.StatusUnauthorized || requests != 1 {
+		t.Fatalf("expected an unauthorized error for the static token after 1 request, got %v after %d", err, requests)
+	}
+}
+
+func TestClient_OnRetry(t *testing.T) {
+	requests := 0
+
+	ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		requests++
+
+		rw.Header().Set("Content-Type", "application/json")
+
+		switch {
+		case requests == 1:
+			rw.WriteHeader(http.StatusTooManyRequests)
+			rw.Write([]byte(`{"errors": [{"reason": "Too many requests"}]}`))
+		case r.Header.Get("Authorization") != "Bearer valid":
+			rw.WriteHeader(http.StatusUnauthorized)
+			rw.Write([]byte(`{"errors": [{"reason": "Invalid Token"}]}`))
+		default:
+			rw.Write([]byte(`{"username": "example"}`))
+		}
+	}))
+	defer ts.Close()
+
+	client := NewClient(nil)
+	client.SetBaseURL(ts.URL)
+	client.SetRetryWaitTime(time.Millisecond)
+
+	var reasons []string
+
+	client.OnRetry(func(_ *resty.Response, attempt int, reason string) {
+		reasons = append(reasons, fmt.Sprintf("%d:%s", attempt, reason))
+	})
+
+	// The condition of the token source is added after the callback
+	source := &testTokenSource{tokens: []string{"expired", "valid"}}
+	client.SetTokenSource(source)
+
+	if _, err := client.GetProfile(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	if expected := []string{"1:tooManyRequests", "2:unauthorized"}; !cmp.Equal(reasons, expected) {
+		t.Errorf("expected retries %v, got %v", expected, reasons)
+	}
+
+	if source.calls != 2 {
+		t.Errorf("expected the token to be refreshed once, got %d tokens", source.calls)
+	}
+}
+
+func TestClient_SetTokenSource_otherRetries(t *testing.T) {
+	var statuses []int
+
+	ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Set("Content-Type", "application/json")
+
+		// Every other request is rate limited
+		status := http.StatusUnauthorized
+		if len(statuses)%2 == 1 {
+			status = http.StatusTooManyRequests
+		}
+
+		statuses = append(statuses, status)
+
+		rw.WriteHeader(status)
+		rw.Write([]byte(`{"errors": [{"reason": "Rejected"}]}`))
+	}))
+	defer ts.Close()
+
+	client := NewClient(nil)
+	client.SetBaseURL(ts.URL)
+	client.SetRetryWaitTime(time.Millisecond)
+	client.SetTokenSource(&testTokenSource{tokens: []string{"revoked", "revoked"}})
+
+	_, err := client.GetProfile(context.Background())
+	if apiErr, ok := err.(*Error); !ok || apiErr.Code != http.StatusUnauthorized {
+		t.Fatalf("expected an unauthorized error, got %v", err)
+	}
+
+	want := []int{http.StatusUnauthorized, http.StatusTooManyRequests, http.StatusUnauthorized}
+	if !cmp.Equal(statuses, want) {
+		t.Errorf("expected a single retry for the 401, got %v", statuses)
+	}
+}
+
+func TestClient_AppendUserAgent(t *testing.T) {
+	var userAgents []string
+
+	ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		userAgents = append(userAgents, r.Header.Get("User-Agent"))
+
+		rw.Header().Set("Content-Type", "application/json")
+
+		if len(userAgents) == 1 {
+			rw.WriteHeader(http.StatusTooManyRequests)
+			rw.Write([]byte(`{"errors": [{"reason": "Too Many Requests"}]}`))
+			return
+		}
+
+		rw.Write([]byte(`{"username": "example"}`))
+	}))
+	defer ts.Close()
+
+	client := NewClient(nil)
+	client.SetBaseURL(ts.URL)
+	client.SetRetryWaitTime(time.Millisecond)
+	client.AppendUserAgent("my-library/0.1").AppendUserAgent(" ").AppendUserAgent("my-operator/1.2")
+
+	want := "my-operator/1.2 my-library/0.1 " + DefaultUserAgent
+	if client.userAgent != want {
+		t.Errorf("expected user-agent %q, got %q", want, client.userAgent)
+	}
+
+	if _, err := client.GetProfile(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	if !cmp.Equal(userAgents, []string{want, want}) {
+		t.Errorf("expected the user-agent to be sent with every attempt, got %v", userAgents)
+	}
+}
+
+func TestClient_SetAPIVersion_invalid(t *testing.T) {
+	logger := &testLogger{}
+
+	client := NewClient(nil)
+	client.SetLogger(logger)
+	client.SetAPIVersion("v4beta")
+
+	for _, apiVersion := range []string{"4", "/v4", "v4/beta", "beta"} {
+		client.SetAPIVersion(apiVersion)
+
+		if client.resty.BaseURL != "https://api.linode.com/v4beta" {
+			t.Errorf("expected invalid API version %q to be ignored, got base URL %s", apiVersion, client.resty.BaseURL)
+		}
+	}
+
+	if len(logger.messages) != 4 {
+		t.Errorf("expected 4 logged errors, got %v", logger.messages)
+	}
+
+	client.SetAPIVersion("")
+
+	if client.resty.BaseURL != "https://api.linode.com/v4" {
+		t.Errorf("expected an empty API version to reset the base URL, got %s", client.resty.BaseURL)
+	}
+}
+
+func TestClient_SetAPIVersion_invalidatesCache(t *testing.T) {
+	client := NewClient(nil)
+	client.UseCache(true)
+	client.addCachedResponse("regions", []Region{{ID: "us-east"}}, nil)
+
+	client.SetAPIVersion(APIVersion)
+
+	if client.getCachedResponse("regions") == nil {
+		t.Fatal("expected the cache to be kept when the API version is unchanged")
+	}
+
+	client.SetAPIVersion("v4beta")
+
+	if client.getCachedResponse("regions") != nil {
+		t.Error("expected the cache to be cleared when the API version changes")
+	}
+}
+
+func TestClient_NewFromEnv_invalidAPIVersion(t *testing.T) {
+	t.Setenv(APIEnvVar, "token")
+	t.Setenv(APIVersionVar, "v4/beta")
+
+	if _, err := NewClientFromEnv(nil); err == nil {
+		t.Error("expected an error for an invalid API version")
+	}
+}
@@ -0,0 +1,55 @@
+package linodego
+
+import (
+	"sync"
+	"sync/atomic"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// clientCloser records whether a Client was closed.
+// It is shared between copies of a Client so closing any copy closes all of them.
+type clientCloser struct {
+	once   sync.Once
+	closed atomic.Bool
+}
+
+// rejectClosed is a resty middleware which fails every request attempt once the Client is closed
+func (c *clientCloser) rejectClosed(_ *resty.Client, _ *resty.Request) error {
+	if c.closed.Load() {
+		return ErrClientClosed
+	}
+
+	return nil
+}
+
+// Close releases the resources of the Client and all of its copies: it flushes the cached
+// responses, ETags, Object Storage endpoints and Monitor service tokens and closes the idle
+// connections of the underlying http.Client. Requests made after Close, including retries of requests that
+// were in flight, fail with ErrClientClosed, and StreamEvents returns ErrClientClosed.
+// Close is safe to call multiple times and concurrently with requests.
+func (c *Client) Close() error {
+	c.closer.once.Do(func() {
+		c.closer.closed.Store(true)
+
+		c.InvalidateCache()
+
+		if c.etags != nil {
+			c.etags.mu.Lock()
+			c.etags.entries = make(map[string]etagEntry)
+			c.etags.mu.Unlock()
+		}
+
+		c.objectStorageEndpoints.mu.Lock()
+		c.objectStorageEndpoints.endpoints = nil
+		c.objectStorageEndpoints.mu.Unlock()
+
+		c.monitorTokens.mu.Lock()
+		c.monitorTokens.tokens = nil
+		c.monitorTokens.mu.Unlock()
+
+		c.resty.GetClient().CloseIdleConnections()
+	})
+
+	return nil
+}
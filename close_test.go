@@ -0,0 +1,69 @@
+package linodego
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestClient_Close(t *testing.T) {
+	ts, client := createTestServer(http.MethodGet, "/v4/account/events", "application/json",
+		`{"data": [], "page": 1, "pages": 1, "results": 0}`, http.StatusOK)
+	defer ts.Close()
+
+	client.SetPollDelay(time.Millisecond)
+	client.UseCache(true)
+	client.addCachedResponse("regions", []Region{{ID: "us-east"}}, nil)
+
+	streamErr := make(chan error, 1)
+
+	go func() {
+		streamErr <- client.StreamEvents(context.Background(), time.Now(), func(Event) {}, func(error) {})
+	}()
+
+	clientCopy := *client
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			if err := clientCopy.Close(); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	select {
+	case err := <-streamErr:
+		if !errors.Is(err, ErrClientClosed) {
+			t.Errorf("expected StreamEvents to return ErrClientClosed, got %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected StreamEvents to return once the client is closed")
+	}
+
+	if _, err := client.ListEvents(context.Background(), nil); !errors.Is(err, ErrClientClosed) {
+		t.Errorf("expected ErrClientClosed, got %v", err)
+	}
+
+	if client.getCachedResponse("regions") != nil {
+		t.Error("expected the cache to be flushed")
+	}
+
+	if err := client.Close(); err != nil {
+		t.Errorf("expected closing twice to succeed, got %v", err)
+	}
+
+	if errors.Is(NewError(errors.New("other")), ErrClientClosed) {
+		t.Error("expected other errors not to match ErrClientClosed")
+	}
+}
@@ -0,0 +1,132 @@
+package linodego
+
+import (
+	"context"
+	"sync"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// concurrencyLimiter is a semaphore limiting the number of requests in flight.
+// It is shared between copies of a Client and is safe for concurrent use.
+type concurrencyLimiter struct {
+	mu sync.Mutex
+
+	// limit is the maximum number of requests in flight, 0 means unlimited
+	limit    int
+	inFlight int
+
+	// released is closed and replaced whenever a request is released
+	released chan struct{}
+}
+
+func newConcurrencyLimiter() *concurrencyLimiter {
+	return &concurrencyLimiter{released: make(chan struct{})}
+}
+
+func (l *concurrencyLimiter) setLimit(limit int) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if limit < 0 {
+		limit = 0
+	}
+
+	l.limit = limit
+
+	// wake up waiting requests as the limit may have increased
+	close(l.released)
+	l.released = make(chan struct{})
+}
+
+// acquire blocks until a request may be sent or the context is done
+func (l *concurrencyLimiter) acquire(ctx context.Context) error {
+	for {
+		l.mu.Lock()
+
+		if l.limit == 0 || l.inFlight < l.limit {
+			l.inFlight++
+			l.mu.Unlock()
+
+			return nil
+		}
+
+		released := l.released
+		l.mu.Unlock()
+
+		select {
+		case <-released:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (l *concurrencyLimiter) release() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.inFlight--
+
+	close(l.released)
+	l.released = make(chan struct{})
+}
+
+type concurrencySlotContextKey struct{}
+
+// concurrencySlot is held by a request from its first attempt until it completes
+type concurrencySlot struct {
+	once sync.Once
+}
+
+// releaseRequest releases the slot held by the request, if any
+func (l *concurrencyLimiter) releaseRequest(req *resty.Request) {
+	if req == nil {
+		return
+	}
+
+	if slot, ok := req.Context().Value(concurrencySlotContextKey{}).(*concurrencySlot); ok {
+		slot.once.Do(l.release)
+	}
+}
+
+// SetMaxConcurrentRequests limits the number of requests in flight for the client and all
+// of its copies. A request holds its slot from its first attempt until it completes, including
+// all of its retries, and blocks until a slot is available or its context is done.
+// A value of 0, the default, removes the limit.
+func (c *Client) SetMaxConcurrentRequests(n int) *Client {
+	if c.concurrencyLimiter == nil {
+		limiter := newConcurrencyLimiter()
+		c.concurrencyLimiter = limiter
+
+		c.resty.OnBeforeRequest(func(_ *resty.Client, req *resty.Request) error {
+			if _, ok := req.Context().Value(concurrencySlotContextKey{}).(*concurrencySlot); ok {
+				return nil
+			}
+
+			if err := limiter.acquire(req.Context()); err != nil {
+				return err
+			}
+
+			req.SetContext(context.WithValue(req.Context(), concurrencySlotContextKey{}, &concurrencySlot{}))
+
+			return nil
+		})
+
+		c.resty.OnSuccess(func(_ *resty.Client, resp *resty.Response) {
+			limiter.releaseRequest(resp.Request)
+		})
+
+		c.resty.OnError(func(req *resty.Request, _ error) {
+			limiter.releaseRequest(req)
+		})
+
+		c.resty.OnPanic(func(req *resty.Request, _ error) {
+			limiter.releaseRequest(req)
+		})
+	}
+
+	c.concurrencyLimiter.setLimit(n)
+
+	return c
+}
@@ -0,0 +1,95 @@
+package linodego
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestClient_SetMaxConcurrentRequests(t *testing.T) {
+	var (
+		inFlight    atomic.Int32
+		maxInFlight atomic.Int32
+		requests    atomic.Int32
+	)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		current := inFlight.Add(1)
+		defer inFlight.Add(-1)
+
+		for {
+			previous := maxInFlight.Load()
+			if current <= previous || maxInFlight.CompareAndSwap(previous, current) {
+				break
+			}
+		}
+
+		time.Sleep(10 * time.Millisecond)
+
+		// fail every other request so that slots are held across retries
+		if requests.Add(1)%2 == 1 {
+			rw.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(`{"id": 123}`))
+	}))
+	defer ts.Close()
+
+	client := NewClient(nil)
+	client.SetBaseURL(ts.URL)
+	client.SetRetryWaitTime(time.Millisecond)
+	client.SetMaxConcurrentRequests(2)
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			if _, err := client.GetInstance(context.Background(), 123); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	if maxInFlight.Load() > 2 {
+		t.Errorf("expected at most 2 requests in flight, got %d", maxInFlight.Load())
+	}
+
+	if client.concurrencyLimiter.inFlight != 0 {
+		t.Errorf("expected all slots to be released, got %d in flight", client.concurrencyLimiter.inFlight)
+	}
+}
+
+func TestClient_SetMaxConcurrentRequests_contextCanceled(t *testing.T) {
+	client := NewClient(nil)
+	client.SetMaxConcurrentRequests(1)
+
+	// hold the only slot
+	if err := client.concurrencyLimiter.acquire(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if _, err := client.GetInstance(ctx, 123); err == nil {
+		t.Error("expected an error once the context deadline is exceeded")
+	}
+
+	client.concurrencyLimiter.release()
+
+	if client.concurrencyLimiter.inFlight != 0 {
+		t.Errorf("expected no slots to be held, got %d", client.concurrencyLimiter.inFlight)
+	}
+}
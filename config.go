@@ -115,6 +115,10 @@ func (c *Client) UseProfile(name string) error {
 		return fmt.Errorf("unable to resolve linode_api_version for profile %s", name)
 	}
 
+	if err := validateAPIVersion(profile.APIVersion); err != nil {
+		return fmt.Errorf("invalid linode_api_version for profile %s: %w", name, err)
+	}
+
 	c.SetToken(profile.APIToken)
 	c.SetBaseURL(profile.APIURL)
 	c.SetAPIVersion(profile.APIVersion)
@@ -53,6 +53,30 @@ const (
 	DatabaseStatusBackingUp    DatabaseStatus = "backing_up"
 )
 
+// InvalidDatabaseError is returned by the engine-independent database helpers
+// when a database cannot be identified by its engine and ID, before any request is made.
+type InvalidDatabaseError struct {
+	Engine DatabaseEngineType
+	ID     int
+}
+
+func (e *InvalidDatabaseError) Error() string {
+	if _, ok := databaseStatusHandlers[e.Engine]; !ok {
+		return fmt.Sprintf("invalid db engine: %s", e.Engine)
+	}
+
+	return fmt.Sprintf("invalid %s database ID: %d", e.Engine, e.ID)
+}
+
+// validateDatabase returns an *InvalidDatabaseError if the engine is unknown or the ID is not positive.
+func validateDatabase(dbEngine DatabaseEngineType, dbID int) error {
+	if _, ok := databaseStatusHandlers[dbEngine]; !ok || dbID < 1 {
+		return &InvalidDatabaseError{Engine: dbEngine, ID: dbID}
+	}
+
+	return nil
+}
+
 type DatabasesPagedResponse struct {
 	*PageOptions
 	Data []Database `json:"data"`
@@ -116,6 +140,7 @@ type Database struct {
 	Status          DatabaseStatus `json:"status"`
 	Label           string         `json:"label"`
 	Hosts           DatabaseHost   `json:"hosts"`
+	Port            int            `json:"port"`
 	Region          string         `json:"region"`
 	Type            string         `json:"type"`
 	Engine          string         `json:"engine"`
@@ -212,7 +237,36 @@ func (c *Client) ListDatabases(ctx context.Context, opts *ListOptions) ([]Databa
 	return response.Data, nil
 }
 
-// ListDatabaseEngines lists all Database Engines. This endpoint is cached by default.
+// RestoreDatabaseFromBackup restores the MySQL or PostgreSQL Database with the given Backup
+// and waits for the restore to complete and the Database to be active again.
+func (c *Client) RestoreDatabaseFromBackup(
+	ctx context.Context, dbID int, dbEngine DatabaseEngineType, backupID int, timeoutSeconds int,
+) error {
+	if err := validateDatabase(dbEngine, dbID); err != nil {
+		return err
+	}
+
+	if backupID < 1 {
+		return fmt.Errorf("invalid %s database backup ID: %d", dbEngine, backupID)
+	}
+
+	var err error
+
+	switch dbEngine {
+	case DatabaseEngineTypeMySQL:
+		err = c.RestoreMySQLDatabaseBackup(ctx, dbID, backupID)
+	case DatabaseEngineTypePostgres:
+		err = c.RestorePostgresDatabaseBackup(ctx, dbID, backupID)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return c.waitForDatabaseRestored(ctx, dbID, dbEngine, timeoutSeconds)
+}
+
+// ListDatabaseEngines lists all Database Engines. This endpoint is cached when response caching is enabled.
 func (c *Client) ListDatabaseEngines(ctx context.Context, opts *ListOptions) ([]DatabaseEngine, error) {
 	response := DatabaseEnginesPagedResponse{}
 
@@ -235,7 +289,7 @@ func (c *Client) ListDatabaseEngines(ctx context.Context, opts *ListOptions) ([]
 	return response.Data, nil
 }
 
-// GetDatabaseEngine returns a specific Database Engine. This endpoint is cached by default.
+// GetDatabaseEngine returns a specific Database Engine. This endpoint is cached when response caching is enabled.
 func (c *Client) GetDatabaseEngine(ctx context.Context, _ *ListOptions, engineID string) (*DatabaseEngine, error) {
 	engineID = url.PathEscape(engineID)
 	e := fmt.Sprintf("databases/engines/%s", engineID)
@@ -256,7 +310,7 @@ func (c *Client) GetDatabaseEngine(ctx context.Context, _ *ListOptions, engineID
 	return r.Result().(*DatabaseEngine), nil
 }
 
-// ListDatabaseTypes lists all Types of Database provided in Linode Managed Databases. This endpoint is cached by default.
+// ListDatabaseTypes lists all Types of Database provided in Linode Managed Databases. This endpoint is cached when response caching is enabled.
 func (c *Client) ListDatabaseTypes(ctx context.Context, opts *ListOptions) ([]DatabaseType, error) {
 	response := DatabaseTypesPagedResponse{}
 
@@ -279,7 +333,7 @@ func (c *Client) ListDatabaseTypes(ctx context.Context, opts *ListOptions) ([]Da
 	return response.Data, nil
 }
 
-// GetDatabaseType returns a specific Database Type. This endpoint is cached by default.
+// GetDatabaseType returns a specific Database Type. This endpoint is cached when response caching is enabled.
 func (c *Client) GetDatabaseType(ctx context.Context, _ *ListOptions, typeID string) (*DatabaseType, error) {
 	typeID = url.PathEscape(typeID)
 	e := fmt.Sprintf("databases/types/%s", typeID)
@@ -0,0 +1,126 @@
+package linodego
+
+import (
+	"context"
+	"net"
+	"net/url"
+	"strconv"
+)
+
+const (
+	defaultMySQLPort    = 3306
+	defaultPostgresPort = 5432
+)
+
+// DatabaseCredentials are the root credentials and CA certificate of a Managed Database of any engine
+type DatabaseCredentials struct {
+	Username string
+	Password string
+
+	// CACertificate is the PEM encoded CA certificate used to verify the connection to the Database
+	CACertificate []byte
+}
+
+// GetDatabaseCredentials gets the root credentials and CA certificate of the MySQL or PostgreSQL Database
+func (c *Client) GetDatabaseCredentials(ctx context.Context, dbID int, dbEngine DatabaseEngineType) (*DatabaseCredentials, error) {
+	if err := validateDatabase(dbEngine, dbID); err != nil {
+		return nil, err
+	}
+
+	creds := &DatabaseCredentials{}
+
+	switch dbEngine {
+	case DatabaseEngineTypeMySQL:
+		credential, err := c.GetMySQLDatabaseCredentials(ctx, dbID)
+		if err != nil {
+			return nil, err
+		}
+
+		ssl, err := c.GetMySQLDatabaseSSL(ctx, dbID)
+		if err != nil {
+			return nil, err
+		}
+
+		creds.Username, creds.Password, creds.CACertificate = credential.Username, credential.Password, ssl.CACertificate
+	case DatabaseEngineTypePostgres:
+		credential, err := c.GetPostgresDatabaseCredentials(ctx, dbID)
+		if err != nil {
+			return nil, err
+		}
+
+		ssl, err := c.GetPostgresDatabaseSSL(ctx, dbID)
+		if err != nil {
+			return nil, err
+		}
+
+		creds.Username, creds.Password, creds.CACertificate = credential.Username, credential.Password, ssl.CACertificate
+	}
+
+	return creds, nil
+}
+
+// ConnectionURI returns a mysql:// or postgresql:// URI connecting to the given database name
+// on the primary host of the Database, see MySQLDatabase.ConnectionURI and PostgresDatabase.ConnectionURI.
+// An empty string is returned for other engines.
+func (d Database) ConnectionURI(creds *DatabaseCredentials, dbName string) string {
+	switch DatabaseEngineType(d.Engine) {
+	case DatabaseEngineTypeMySQL:
+		return mysqlConnectionURI(d.Hosts.Primary, d.Port, creds.Username, creds.Password, dbName, d.SSLConnection)
+	case DatabaseEngineTypePostgres:
+		return postgresConnectionURI(d.Hosts.Primary, d.Port, creds.Username, creds.Password, dbName, d.SSLConnection)
+	}
+
+	return ""
+}
+
+// ConnectionURI returns a mysql:// URI connecting to the given database name on the primary host.
+// If the Database requires SSL connections, ssl-mode=REQUIRED is set. To also verify the server,
+// save the CACertificate of GetMySQLDatabaseSSL to a file and pass it using ssl-ca.
+func (d MySQLDatabase) ConnectionURI(creds *MySQLDatabaseCredential, dbName string) string {
+	return mysqlConnectionURI(d.Hosts.Primary, d.Port, creds.Username, creds.Password, dbName, d.SSLConnection)
+}
+
+// ConnectionURI returns a postgresql:// URI connecting to the given database name on the primary host.
+// If the Database requires SSL connections, sslmode=require is set. To also verify the server,
+// save the CACertificate of GetPostgresDatabaseSSL to a file and pass it using sslrootcert.
+func (d PostgresDatabase) ConnectionURI(creds *PostgresDatabaseCredential, dbName string) string {
+	return postgresConnectionURI(d.Hosts.Primary, d.Port, creds.Username, creds.Password, dbName, d.SSLConnection)
+}
+
+func mysqlConnectionURI(host string, port int, username, password, dbName string, ssl bool) string {
+	if port == 0 {
+		port = defaultMySQLPort
+	}
+
+	query := url.Values{}
+	if ssl {
+		query.Set("ssl-mode", "REQUIRED")
+	}
+
+	return databaseConnectionURI("mysql", host, port, username, password, dbName, query)
+}
+
+func postgresConnectionURI(host string, port int, username, password, dbName string, ssl bool) string {
+	if port == 0 {
+		port = defaultPostgresPort
+	}
+
+	query := url.Values{}
+	if ssl {
+		query.Set("sslmode", "require")
+	}
+
+	return databaseConnectionURI("postgresql", host, port, username, password, dbName, query)
+}
+
+func databaseConnectionURI(scheme, host string, port int, username, password, dbName string, query url.Values) string {
+	u := url.URL{
+		Scheme:   scheme,
+		User:     url.UserPassword(username, password),
+		Host:     net.JoinHostPort(host, strconv.Itoa(port)),
+		Path:     "/" + dbName,
+		RawQuery: query.Encode(),
+	}
+
+	return u.String()
+}
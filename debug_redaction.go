@@ -0,0 +1,121 @@
+package linodego
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// redactedLogValue replaces the values of redacted headers and fields in debug output
+const redactedLogValue = "[REDACTED]"
+
+// defaultDebugRedactionKeys are the headers and body fields redacted from the debug output of every Client
+var defaultDebugRedactionKeys = []string{
+	"Authorization", "root_pass", "password", "access_key", "secret_key", "private_key", "api_key", "token",
+}
+
+// logRedactor masks the values of secret headers and JSON body fields in debug output.
+// It is shared between copies of a Client so keys added after the Client was created apply to all of them.
+type logRedactor struct {
+	mu   sync.RWMutex
+	keys map[string]struct{}
+}
+
+func newLogRedactor() *logRedactor {
+	r := &logRedactor{keys: make(map[string]struct{})}
+	r.addKeys(defaultDebugRedactionKeys...)
+
+	return r
+}
+
+func (r *logRedactor) addKeys(keys ...string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, key := range keys {
+		r.keys[strings.ToLower(key)] = struct{}{}
+	}
+}
+
+// redacts reports whether the value of a header or field is redacted, ignoring case
+func (r *logRedactor) redacts(key string) bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	_, ok := r.keys[strings.ToLower(key)]
+
+	return ok
+}
+
+func (r *logRedactor) redactHeader(header http.Header) {
+	for name := range header {
+		if r.redacts(name) {
+			header[name] = []string{redactedLogValue}
+		}
+	}
+}
+
+// redactBody redacts the fields of a JSON body at any depth.
+// Bodies that are not JSON are returned unchanged.
+func (r *logRedactor) redactBody(body string) string {
+	decoder := json.NewDecoder(strings.NewReader(body))
+	decoder.UseNumber()
+
+	var value any
+	if err := decoder.Decode(&value); err != nil || decoder.More() {
+		return body
+	}
+
+	if !r.redactValue(value) {
+		return body
+	}
+
+	var out bytes.Buffer
+
+	encoder := json.NewEncoder(&out)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "   ")
+
+	if err := encoder.Encode(value); err != nil {
+		return body
+	}
+
+	return strings.TrimSuffix(out.String(), "\n")
+}
+
+// redactValue redacts the fields of a decoded JSON value in place,
+// reporting whether any field was redacted.
+func (r *logRedactor) redactValue(value any) bool {
+	redacted := false
+
+	switch v := value.(type) {
+	case map[string]any:
+		for key, field := range v {
+			if r.redacts(key) {
+				v[key] = redactedLogValue
+				redacted = true
+
+				continue
+			}
+
+			redacted = r.redactValue(field) || redacted
+		}
+	case []any:
+		for _, item := range v {
+			redacted = r.redactValue(item) || redacted
+		}
+	}
+
+	return redacted
+}
+
+// AddDebugRedactionKeys adds headers and JSON body fields, matched ignoring case, whose values
+// are redacted from the output of SetDebug. The Authorization header and the root_pass, password,
+// access_key, secret_key, private_key, api_key and token fields are always redacted.
+func (c *Client) AddDebugRedactionKeys(keys ...string) *Client {
+	c.redactor.addKeys(keys...)
+
+	return c
+}
@@ -0,0 +1,78 @@
+package linodego
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestClient_SetDebug_redaction(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Header().Set("X-Session", "response-session")
+		rw.Write([]byte(`{"id": 123, "label": "example", "secret_key": "response-secret", "access_key": "response-access", "region": "visible-region"}`))
+	}))
+	defer ts.Close()
+
+	logger := &testLogger{}
+
+	client := NewClient(nil)
+	client.SetBaseURL(ts.URL)
+	client.SetToken("request-token")
+	client.SetLogger(logger)
+	client.SetDebug(true)
+	client.AddDebugRedactionKeys("x-session", "EXTRA_SECRET")
+
+	_, err := client.CreateInstance(context.Background(), InstanceCreateOptions{
+		Region:          "us-east",
+		Type:            "g6-nanode-1",
+		RootPass:        "request-root-pass",
+		StackScriptData: map[string]string{"extra_secret": "request-extra", "hostname": "visible-hostname"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	output := strings.Join(logger.messages, "\n")
+
+	for _, secret := range []string{"request-token", "request-root-pass", "request-extra", "response-secret", "response-access", "response-session"} {
+		if strings.Contains(output, secret) {
+			t.Errorf("expected %q to be redacted from the debug output:\n%s", secret, output)
+		}
+	}
+
+	for _, value := range []string{"POST", "/v4/linode/instances", "visible-hostname", "visible-region", redactedLogValue} {
+		if !strings.Contains(output, value) {
+			t.Errorf("expected %q in the debug output:\n%s", value, output)
+		}
+	}
+}
+
+func TestLogRedactor_redactBody(t *testing.T) {
+	r := newLogRedactor()
+
+	for _, body := range []string{"***** NO CONTENT *****", `{"id": 1}`, `{"truncated": `} {
+		if got := r.redactBody(body); got != body {
+			t.Errorf("expected %q to be unchanged, got %q", body, got)
+		}
+	}
+
+	got := r.redactBody(`{"keys": [{"id": 12345678901234, "private_key": "secret"}]}`)
+	if strings.Contains(got, "secret") || !strings.Contains(got, "12345678901234") {
+		t.Errorf("unexpected redacted body %s", got)
+	}
+
+	// Database credentials and Object Storage keys
+	got = r.redactBody(`{"username": "linroot", "password": "db-secret", "access_key": "KEYID", "secret_key": "key-secret"}`)
+	for _, secret := range []string{"db-secret", "KEYID", "key-secret"} {
+		if strings.Contains(got, secret) {
+			t.Errorf("expected %q to be redacted, got %s", secret, got)
+		}
+	}
+
+	if !strings.Contains(got, "linroot") {
+		t.Errorf("expected the username not to be redacted, got %s", got)
+	}
+}
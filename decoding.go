@@ -0,0 +1,197 @@
+package linodego
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// UnknownFieldsError is returned when strict decoding is enabled and a response
+// contains fields that are not known to linodego, see SetStrictDecoding.
+type UnknownFieldsError struct {
+	// Fields are the paths of the unknown fields, e.g. "data.0.new_field"
+	Fields []string
+}
+
+func (e UnknownFieldsError) Error() string {
+	return fmt.Sprintf("unknown fields %s", strings.Join(e.Fields, ", "))
+}
+
+// SetStrictDecoding sets whether responses containing fields that are not known to linodego,
+// e.g. fields recently added to the API, fail to decode with an UnknownFieldsError.
+// By default unknown fields are ignored. Strict decoding is meant to catch API drift in tests.
+func (c *Client) SetStrictDecoding(strict bool) *Client {
+	c.strictDecoding = strict
+	c.updateJSONUnmarshaler()
+
+	return c
+}
+
+// strictJSONUnmarshal wraps unmarshal to fail if data contains fields unknown to v.
+// Most types are unmarshaled through a Mask of their own type, so unknown fields are
+// found by matching the decoded data against the fields of v rather than by the json.Decoder.
+func strictJSONUnmarshal(unmarshal func(data []byte, v any) error) func(data []byte, v any) error {
+	return func(data []byte, v any) error {
+		if err := unmarshal(data, v); err != nil {
+			return err
+		}
+
+		return unknownFieldsError(data, v)
+	}
+}
+
+// unknownFieldsError returns an UnknownFieldsError if data contains fields unknown to v
+func unknownFieldsError(data []byte, v any) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		return err
+	}
+
+	var unknown []string
+
+	unknownJSONFields(value, reflect.TypeOf(v), "", &unknown)
+
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return UnknownFieldsError{Fields: unknown}
+	}
+
+	return nil
+}
+
+var rawMessageType = reflect.TypeOf(json.RawMessage{})
+
+// unknownJSONFields appends the paths of the fields of value that are not fields of t to unknown
+func unknownJSONFields(value any, t reflect.Type, path string, unknown *[]string) {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	if t == rawMessageType {
+		return
+	}
+
+	switch v := value.(type) {
+	case map[string]any:
+		switch t.Kind() {
+		case reflect.Map:
+			for key, field := range v {
+				unknownJSONFields(field, t.Elem(), joinJSONPath(path, key), unknown)
+			}
+		case reflect.Struct:
+			fields := jsonFields(t)
+
+			for key, field := range v {
+				fieldType, ok := fields[normalizeJSONName(key)]
+				if !ok {
+					*unknown = append(*unknown, joinJSONPath(path, key))
+					continue
+				}
+
+				unknownJSONFields(field, fieldType, joinJSONPath(path, key), unknown)
+			}
+		}
+	case []any:
+		if t.Kind() == reflect.Slice || t.Kind() == reflect.Array {
+			for i, item := range v {
+				unknownJSONFields(item, t.Elem(), joinJSONPath(path, strconv.Itoa(i)), unknown)
+			}
+		}
+	}
+}
+
+// jsonFields returns the types of the fields of the struct t by their normalized JSON name.
+// Fields that are not decoded directly, i.e. tagged with "-", are matched by their Go name
+// as they are typically decoded by the UnmarshalJSON method of the struct.
+func jsonFields(t reflect.Type) map[string]reflect.Type {
+	fields := make(map[string]reflect.Type)
+	untagged := make(map[string]reflect.Type)
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+
+		if field.Anonymous && name == "" {
+			embedded := field.Type
+			for embedded.Kind() == reflect.Pointer {
+				embedded = embedded.Elem()
+			}
+
+			if embedded.Kind() == reflect.Struct {
+				for key, fieldType := range jsonFields(embedded) {
+					fields[key] = fieldType
+				}
+
+				continue
+			}
+		}
+
+		if !field.IsExported() {
+			continue
+		}
+
+		switch name {
+		case "-":
+			untagged[normalizeJSONName(field.Name)] = field.Type
+		case "":
+			fields[normalizeJSONName(field.Name)] = field.Type
+		default:
+			fields[normalizeJSONName(name)] = field.Type
+		}
+	}
+
+	for key, fieldType := range untagged {
+		if _, ok := fields[key]; !ok {
+			fields[key] = fieldType
+		}
+	}
+
+	return fields
+}
+
+// normalizeJSONName matches JSON names case-insensitively and regardless of underscores,
+// e.g. "unit_price" matches the UnitPrice field
+func normalizeJSONName(name string) string {
+	return strings.ToLower(strings.ReplaceAll(name, "_", ""))
+}
+
+func joinJSONPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+
+	return path + "." + key
+}
+
+// decodingError adds the request of the response, and the field that could not be decoded
+// if known, to errors returned while decoding its body. Other errors are returned unchanged.
+func decodingError(r *resty.Response, err error) error {
+	if r == nil || r.Request == nil {
+		return err
+	}
+
+	var (
+		typeError     *json.UnmarshalTypeError
+		syntaxError   *json.SyntaxError
+		unknownFields UnknownFieldsError
+	)
+
+	switch {
+	case errors.As(err, &typeError) && typeError.Field != "":
+		return fmt.Errorf("failed to decode field %q of the response to %s %s: %w", typeError.Field, r.Request.Method, r.Request.URL, err)
+	case errors.As(err, &typeError), errors.As(err, &syntaxError), errors.As(err, &unknownFields):
+		return fmt.Errorf("failed to decode the response to %s %s: %w", r.Request.Method, r.Request.URL, err)
+	}
+
+	return err
+}
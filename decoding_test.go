@@ -0,0 +1,110 @@
+package linodego
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestStrictJSONUnmarshal(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		body     string
+		v        any
+		expected []string
+	}{
+		{
+			name: "known fields",
+			body: `{"id": 123, "label": "example", "created": "2024-01-02T03:04:05", "updated": "2024-01-02T03:04:05",
+				"specs": {"disk": 25600, "memory": 1024}, "tags": ["example"], "placement_group": {"id": 1, "label": "pg"}}`,
+			v: &Instance{},
+		},
+		{
+			name: "fields decoded by UnmarshalJSON",
+			body: `{"label": "example", "unit_price": "0.0075", "from": "2024-01-01T00:00:00", "to": "2024-02-01T00:00:00"}`,
+			v:    &InvoiceItem{},
+		},
+		{
+			name:     "embedded page options",
+			body:     `{"data": [{"id": 1, "label": "a"}, {"id": 2, "new_field": true}], "page": 1, "pages": 1, "results": 2}`,
+			v:        &InstancesPagedResponse{},
+			expected: []string{"data.1.new_field"},
+		},
+		{
+			name:     "nested fields",
+			body:     `{"id": 123, "specs": {"disk": 25600, "new_spec": 1}, "new_field": "value"}`,
+			v:        &Instance{},
+			expected: []string{"new_field", "specs.new_spec"},
+		},
+		{
+			name: "maps and raw messages",
+			body: `{"type": "linode", "data": {"anything": true}}`,
+			v:    &TaggedObject{},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := strictJSONUnmarshal(json.Unmarshal)([]byte(tc.body), tc.v)
+
+			if tc.expected == nil {
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				return
+			}
+
+			var unknownFields UnknownFieldsError
+			if !errors.As(err, &unknownFields) {
+				t.Fatalf("expected an UnknownFieldsError, got %v", err)
+			}
+
+			if !reflect.DeepEqual(unknownFields.Fields, tc.expected) {
+				t.Errorf("expected unknown fields %v, got %v", tc.expected, unknownFields.Fields)
+			}
+		})
+	}
+}
+
+func TestClient_SetStrictDecoding(t *testing.T) {
+	ts, client := createTestServer(http.MethodGet, "/v4/linode/instances/123", "application/json",
+		`{"id": 123, "label": "example", "new_field": true}`, http.StatusOK)
+	defer ts.Close()
+
+	if _, err := client.GetInstance(context.Background(), 123); err != nil {
+		t.Fatalf("expected unknown fields to be ignored by default, got %v", err)
+	}
+
+	client.SetStrictDecoding(true)
+
+	_, err := client.GetInstance(context.Background(), 123)
+	if err == nil || !strings.Contains(err.Error(), "new_field") || !strings.Contains(err.Error(), "GET "+ts.URL+"/v4/linode/instances/123") {
+		t.Errorf("expected an unknown field error for the request, got %v", err)
+	}
+
+	client.SetStrictDecoding(false)
+
+	if _, err := client.GetInstance(context.Background(), 123); err != nil {
+		t.Errorf("expected unknown fields to be ignored, got %v", err)
+	}
+}
+
+func TestClient_decodingError(t *testing.T) {
+	ts, client := createTestServer(http.MethodGet, "/v4/volumes/123", "application/json",
+		`{"id": 123, "size": "large"}`, http.StatusOK)
+	defer ts.Close()
+
+	_, err := client.GetVolume(context.Background(), 123)
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+
+	for _, value := range []string{`field "size"`, "GET " + ts.URL + "/v4/volumes/123"} {
+		if !strings.Contains(err.Error(), value) {
+			t.Errorf("expected %q in the error, got %v", value, err)
+		}
+	}
+}
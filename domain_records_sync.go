@@ -0,0 +1,132 @@
+package linodego
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// DomainRecordSyncResult contains the records changed by SyncDomainRecords
+type DomainRecordSyncResult struct {
+	Created   []DomainRecord
+	Updated   []DomainRecord
+	Deleted   []DomainRecord
+	Unchanged []DomainRecord
+}
+
+// SyncDomainRecords converges the records of a Domain to the desired records. Records are matched
+// on their type, name and target: desired records without a matching record are created, matching
+// records with a different TTL, priority, weight, port, service, protocol or tag are updated, and
+// records that match no desired record are deleted. The ID of desired records is ignored, and a
+// TTLSec of 0 keeps the TTL of a matching record.
+//
+// Up to DefaultBatchConcurrency deletes are made concurrently; requests are still subject to the request rate limit of the client.
+// Failed changes do not stop the others from being applied. The returned result contains every
+// change that was applied, and the returned error joins the errors of those that failed.
+func (c *Client) SyncDomainRecords(ctx context.Context, domainID int, desired []DomainRecord) (*DomainRecordSyncResult, error) {
+	existing, err := c.ListDomainRecords(ctx, domainID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &DomainRecordSyncResult{}
+	consumed := make([]bool, len(existing))
+
+	var errs []error
+
+	for _, record := range desired {
+		i := findSyncedDomainRecord(existing, consumed, record)
+		if i < 0 {
+			created, err := c.CreateDomainRecord(ctx, domainID, domainRecordCreateOptions(record))
+			if err != nil {
+				errs = append(errs, fmt.Errorf("failed to create %s record %q: %w", record.Type, record.Name, err))
+				continue
+			}
+
+			result.Created = append(result.Created, *created)
+
+			continue
+		}
+
+		consumed[i] = true
+
+		if domainRecordSettingsMatch(existing[i], record) {
+			result.Unchanged = append(result.Unchanged, existing[i])
+			continue
+		}
+
+		updated, err := c.UpdateDomainRecord(ctx, domainID, existing[i].ID, record.GetUpdateOptions())
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to update %s record %d: %w", record.Type, existing[i].ID, err))
+			continue
+		}
+
+		result.Updated = append(result.Updated, *updated)
+	}
+
+	var stale []DomainRecord
+
+	for i, record := range existing {
+		if !consumed[i] {
+			stale = append(stale, record)
+		}
+	}
+
+	deleteErrs, _ := batchDo(ctx, len(stale), nil, func(ctx context.Context, i int) error {
+		return c.DeleteDomainRecord(ctx, domainID, stale[i].ID)
+	})
+
+	for i, err := range deleteErrs {
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to delete %s record %d: %w", stale[i].Type, stale[i].ID, err))
+			continue
+		}
+
+		result.Deleted = append(result.Deleted, stale[i])
+	}
+
+	return result, errors.Join(errs...)
+}
+
+// findSyncedDomainRecord returns the index of the first existing record that is not consumed
+// and has the same type, name and target as record, or -1.
+func findSyncedDomainRecord(existing []DomainRecord, consumed []bool, record DomainRecord) int {
+	for i, e := range existing {
+		if !consumed[i] && e.Type == record.Type && strings.EqualFold(e.Name, record.Name) &&
+			normalizeDomainRecordTarget(e.Type, e.Target) == normalizeDomainRecordTarget(record.Type, record.Target) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// domainRecordSettingsMatch reports whether the settings of a record other than its type, name and target match
+func domainRecordSettingsMatch(existing, desired DomainRecord) bool {
+	if desired.TTLSec != 0 && roundDomainRecordTTL(desired.TTLSec) != existing.TTLSec {
+		return false
+	}
+
+	return existing.Priority == desired.Priority &&
+		existing.Weight == desired.Weight &&
+		existing.Port == desired.Port &&
+		stringValue(existing.Service) == stringValue(desired.Service) &&
+		stringValue(existing.Protocol) == stringValue(desired.Protocol) &&
+		stringValue(existing.Tag) == stringValue(desired.Tag)
+}
+
+func domainRecordCreateOptions(d DomainRecord) DomainRecordCreateOptions {
+	return DomainRecordCreateOptions{
+		Type:     d.Type,
+		Name:     d.Name,
+		Target:   d.Target,
+		Priority: copyInt(&d.Priority),
+		Weight:   copyInt(&d.Weight),
+		Port:     copyInt(&d.Port),
+		Service:  copyString(d.Service),
+		Protocol: copyString(d.Protocol),
+		TTLSec:   d.TTLSec,
+		Tag:      copyString(d.Tag),
+	}
+}
@@ -0,0 +1,84 @@
+package linodego
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestClient_SyncDomainRecords(t *testing.T) {
+	var (
+		mu      sync.Mutex
+		created []DomainRecordCreateOptions
+		updated []string
+		deleted []string
+	)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		rw.Header().Set("Content-Type", "application/json")
+
+		switch {
+		case r.URL.Path == "/v4/domains/1/records" && r.Method == http.MethodGet:
+			rw.Write([]byte(`{"data": [
+				{"id": 10, "type": "A", "name": "", "target": "192.0.2.1", "ttl_sec": 300},
+				{"id": 11, "type": "MX", "name": "", "target": "mail.example.com", "priority": 10, "ttl_sec": 300},
+				{"id": 12, "type": "A", "name": "old", "target": "192.0.2.9", "ttl_sec": 300},
+				{"id": 13, "type": "TXT", "name": "old", "target": "stale", "ttl_sec": 300}
+			], "page": 1, "pages": 1, "results": 4}`))
+		case r.URL.Path == "/v4/domains/1/records" && r.Method == http.MethodPost:
+			var opts DomainRecordCreateOptions
+			json.NewDecoder(r.Body).Decode(&opts)
+
+			created = append(created, opts)
+			json.NewEncoder(rw).Encode(DomainRecord{ID: 20, Type: opts.Type, Name: opts.Name, Target: opts.Target})
+		case r.Method == http.MethodPut:
+			updated = append(updated, r.URL.Path)
+			rw.Write([]byte(`{"id": 11, "type": "MX", "priority": 20}`))
+		case r.Method == http.MethodDelete:
+			deleted = append(deleted, r.URL.Path)
+			rw.Write([]byte(`{}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			rw.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	client := NewClient(nil)
+	client.SetBaseURL(ts.URL)
+
+	result, err := client.SyncDomainRecords(context.Background(), 1, []DomainRecord{
+		{Type: RecordTypeA, Name: "", Target: "192.0.2.1"},
+		{Type: RecordTypeMX, Name: "", Target: "MAIL.example.com.", Priority: 20, TTLSec: 300},
+		{Type: RecordTypeA, Name: "www", Target: "192.0.2.2", TTLSec: 3600},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(result.Unchanged) != 1 || result.Unchanged[0].ID != 10 {
+		t.Errorf("expected the apex A record to be unchanged, got %v", result.Unchanged)
+	}
+
+	if len(updated) != 1 || updated[0] != "/v4/domains/1/records/11" {
+		t.Errorf("expected the MX record to be updated, got %v", updated)
+	}
+
+	if len(created) != 1 || created[0].Name != "www" {
+		t.Errorf("expected the www record to be created, got %v", created)
+	}
+
+	sort.Strings(deleted)
+
+	if strings.Join(deleted, ",") != "/v4/domains/1/records/12,/v4/domains/1/records/13" || len(result.Deleted) != 2 {
+		t.Errorf("expected the stale records to be deleted, got %v", deleted)
+	}
+}
@@ -0,0 +1,633 @@
+package linodego
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+)
+
+// domainRecordTTLs are the TTLs accepted for Domain Records, other values are rounded up by the API
+var domainRecordTTLs = []int{
+	0, 30, 120, 300, 3600, 7200, 14400, 28800, 57600, 86400, 172800, 345600, 604800, 1209600, 2419200,
+}
+
+// DomainZoneImportResult contains the records touched by ImportDomainRecordsFromZoneFile
+type DomainZoneImportResult struct {
+	Created   []DomainRecord
+	Updated   []DomainRecord
+	Unchanged []DomainRecord
+
+	// Errors contains an error for each record of the zone file that could not be parsed or imported
+	Errors []DomainZoneRecordError
+}
+
+// DomainZoneRecordError is an error parsing or importing a single record of a zone file
+type DomainZoneRecordError struct {
+	// Line is the line of the zone file the record starts on
+	Line int
+
+	// Record is the text of the record
+	Record string
+
+	Err error
+}
+
+func (e DomainZoneRecordError) Error() string {
+	return fmt.Sprintf("line %d (%s): %s", e.Line, e.Record, e.Err)
+}
+
+func (e DomainZoneRecordError) Unwrap() error {
+	return e.Err
+}
+
+// String returns the zone file as a single string
+func (z DomainZoneFile) String() string {
+	return strings.Join(z.ZoneFile, "\n")
+}
+
+// zoneFileRecord is a record parsed from a zone file
+type zoneFileRecord struct {
+	line int
+	text string
+	opts DomainRecordCreateOptions
+}
+
+// ImportDomainRecordsFromZoneFile parses a BIND-format zone file and creates or updates the
+// records of the Domain to match it. Records that already match are left untouched, so importing
+// the same zone file again makes no changes. A record of the zone file that differs from an existing
+// record of the same type and name only in its target, TTL or other values updates that record.
+// Existing records that are not in the zone file are not deleted.
+//
+// SOA records and NS records of the Domain itself are skipped, since they are managed by Linode.
+// Records that fail to parse or import are reported in the Errors of the result and the import
+// continues; the returned error then joins all of them.
+func (c *Client) ImportDomainRecordsFromZoneFile(ctx context.Context, domainID int, zone string) (*DomainZoneImportResult, error) {
+	domain, err := c.GetDomain(ctx, domainID)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := c.ListDomainRecords(ctx, domainID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	records, parseErrs := parseZoneFile(zone, domain.Domain)
+	result := &DomainZoneImportResult{Errors: parseErrs}
+	consumed := make([]bool, len(existing))
+
+	// Match unchanged records first so that changed records update the remaining ones
+	var changed []zoneFileRecord
+
+	for _, record := range records {
+		if i := findDomainRecord(existing, consumed, record.opts, true); i >= 0 {
+			consumed[i] = true
+			result.Unchanged = append(result.Unchanged, existing[i])
+
+			continue
+		}
+
+		changed = append(changed, record)
+	}
+
+	for _, record := range changed {
+		if i := findDomainRecord(existing, consumed, record.opts, false); i >= 0 {
+			consumed[i] = true
+
+			updated, err := c.UpdateDomainRecord(ctx, domainID, existing[i].ID, domainRecordUpdateOptions(record.opts))
+			if err != nil {
+				result.Errors = append(result.Errors, DomainZoneRecordError{Line: record.line, Record: record.text, Err: err})
+				continue
+			}
+
+			result.Updated = append(result.Updated, *updated)
+
+			continue
+		}
+
+		created, err := c.CreateDomainRecord(ctx, domainID, record.opts)
+		if err != nil {
+			result.Errors = append(result.Errors, DomainZoneRecordError{Line: record.line, Record: record.text, Err: err})
+			continue
+		}
+
+		result.Created = append(result.Created, *created)
+	}
+
+	if len(result.Errors) > 0 {
+		errs := make([]error, len(result.Errors))
+		for i, recordErr := range result.Errors {
+			errs[i] = recordErr
+		}
+
+		return result, errors.Join(errs...)
+	}
+
+	return result, nil
+}
+
+// findDomainRecord returns the index of the first existing record that is not consumed and has the
+// same type and name as opts, or -1. If exact is set, all values of the record must also match.
+func findDomainRecord(existing []DomainRecord, consumed []bool, opts DomainRecordCreateOptions, exact bool) int {
+	for i, record := range existing {
+		if consumed[i] || record.Type != opts.Type || !domainRecordIdentityMatches(record, opts) {
+			continue
+		}
+
+		if !exact || domainRecordValuesMatch(record, opts) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func domainRecordIdentityMatches(record DomainRecord, opts DomainRecordCreateOptions) bool {
+	switch opts.Type {
+	case RecordTypeSRV:
+		service := strings.TrimPrefix(stringValue(record.Service), "_")
+		protocol := strings.TrimPrefix(stringValue(record.Protocol), "_")
+
+		// The name of an SRV record may or may not include the service and protocol
+		name := strings.TrimPrefix(strings.ToLower(record.Name), "_"+service+"._"+protocol)
+		name = strings.TrimPrefix(name, ".")
+
+		return strings.EqualFold(service, stringValue(opts.Service)) &&
+			strings.EqualFold(protocol, stringValue(opts.Protocol)) &&
+			name == strings.ToLower(opts.Name)
+	case RecordTypeCAA:
+		return strings.EqualFold(record.Name, opts.Name) && strings.EqualFold(stringValue(record.Tag), stringValue(opts.Tag))
+	default:
+		return strings.EqualFold(record.Name, opts.Name)
+	}
+}
+
+func domainRecordValuesMatch(record DomainRecord, opts DomainRecordCreateOptions) bool {
+	if opts.TTLSec != 0 && roundDomainRecordTTL(opts.TTLSec) != record.TTLSec {
+		return false
+	}
+
+	switch opts.Type {
+	case RecordTypeMX:
+		if intValue(opts.Priority) != record.Priority {
+			return false
+		}
+	case RecordTypeSRV:
+		if intValue(opts.Priority) != record.Priority || intValue(opts.Weight) != record.Weight ||
+			intValue(opts.Port) != record.Port {
+			return false
+		}
+	}
+
+	return normalizeDomainRecordTarget(opts.Type, record.Target) == normalizeDomainRecordTarget(opts.Type, opts.Target)
+}
+
+func normalizeDomainRecordTarget(recordType DomainRecordType, target string) string {
+	switch recordType {
+	case RecordTypeA, RecordTypeAAAA:
+		if ip := net.ParseIP(target); ip != nil {
+			return ip.String()
+		}
+	case RecordTypeTXT, RecordTypeCAA:
+		return target
+	}
+
+	return strings.ToLower(strings.TrimSuffix(target, "."))
+}
+
+// roundDomainRecordTTL rounds ttl up to the nearest TTL accepted by the API
+func roundDomainRecordTTL(ttl int) int {
+	for _, valid := range domainRecordTTLs {
+		if ttl <= valid {
+			return valid
+		}
+	}
+
+	return domainRecordTTLs[len(domainRecordTTLs)-1]
+}
+
+func domainRecordUpdateOptions(opts DomainRecordCreateOptions) DomainRecordUpdateOptions {
+	return DomainRecordUpdateOptions{
+		Type:     opts.Type,
+		Name:     opts.Name,
+		Target:   opts.Target,
+		Priority: copyInt(opts.Priority),
+		Weight:   copyInt(opts.Weight),
+		Port:     copyInt(opts.Port),
+		Service:  copyString(opts.Service),
+		Protocol: copyString(opts.Protocol),
+		TTLSec:   opts.TTLSec,
+		Tag:      copyString(opts.Tag),
+	}
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+
+	return *s
+}
+
+func intValue(i *int) int {
+	if i == nil {
+		return 0
+	}
+
+	return *i
+}
+
+// zoneFileEntry is a directive or record of a zone file spanning one or more lines
+type zoneFileEntry struct {
+	line       int
+	text       string
+	tokens     []string
+	blankOwner bool
+}
+
+// parseZoneFile parses the records of a BIND-format zone file for domain, returning an
+// error for each record that could not be parsed.
+func parseZoneFile(zone, domain string) ([]zoneFileRecord, []DomainZoneRecordError) {
+	domain = strings.ToLower(strings.TrimSuffix(domain, "."))
+	origin := domain
+	owner := domain
+	defaultTTL := 0
+
+	var (
+		records []zoneFileRecord
+		errs    []DomainZoneRecordError
+	)
+
+	for _, entry := range splitZoneFile(zone) {
+		fail := func(err error) {
+			errs = append(errs, DomainZoneRecordError{Line: entry.line, Record: entry.text, Err: err})
+		}
+
+		tokens := entry.tokens
+
+		switch strings.ToUpper(tokens[0]) {
+		case "$ORIGIN":
+			if len(tokens) < 2 {
+				fail(errors.New("$ORIGIN requires a domain name"))
+				continue
+			}
+
+			origin = zoneFileName(tokens[1], origin)
+
+			continue
+		case "$TTL":
+			if len(tokens) < 2 {
+				fail(errors.New("$TTL requires a TTL"))
+				continue
+			}
+
+			ttl, err := parseZoneFileTTL(tokens[1])
+			if err != nil {
+				fail(err)
+				continue
+			}
+
+			defaultTTL = ttl
+
+			continue
+		case "$INCLUDE", "$GENERATE":
+			fail(fmt.Errorf("%s is not supported", tokens[0]))
+			continue
+		}
+
+		if !entry.blankOwner {
+			owner = zoneFileName(tokens[0], origin)
+			tokens = tokens[1:]
+		}
+
+		ttl := defaultTTL
+
+		// The TTL and class are optional and may be given in either order
+		for len(tokens) > 0 {
+			if isZoneFileClass(tokens[0]) {
+				tokens = tokens[1:]
+			} else if t, err := parseZoneFileTTL(tokens[0]); err == nil {
+				ttl = t
+				tokens = tokens[1:]
+			} else {
+				break
+			}
+		}
+
+		if len(tokens) == 0 {
+			fail(errors.New("missing record type"))
+			continue
+		}
+
+		recordType := DomainRecordType(strings.ToUpper(tokens[0]))
+
+		// The SOA and nameservers of a Domain are managed by Linode
+		if recordType == "SOA" || (recordType == RecordTypeNS && owner == domain) {
+			continue
+		}
+
+		name, err := zoneFileRelativeName(owner, domain)
+		if err != nil {
+			fail(err)
+			continue
+		}
+
+		opts, err := parseZoneFileRecordData(recordType, name, tokens[1:], origin)
+		if err != nil {
+			fail(err)
+			continue
+		}
+
+		opts.TTLSec = ttl
+
+		records = append(records, zoneFileRecord{line: entry.line, text: entry.text, opts: opts})
+	}
+
+	return records, errs
+}
+
+// parseZoneFileRecordData parses the data of a record with the given name relative to the domain
+func parseZoneFileRecordData(recordType DomainRecordType, name string, data []string, origin string) (DomainRecordCreateOptions, error) {
+	opts := DomainRecordCreateOptions{Type: recordType, Name: name}
+
+	args := func(n int) error {
+		if len(data) != n {
+			return fmt.Errorf("%s records require %d values, got %d", recordType, n, len(data))
+		}
+
+		return nil
+	}
+
+	switch recordType {
+	case RecordTypeA, RecordTypeAAAA:
+		if err := args(1); err != nil {
+			return opts, err
+		}
+
+		ip := net.ParseIP(data[0])
+		if ip == nil || (ip.To4() != nil) != (recordType == RecordTypeA) {
+			return opts, fmt.Errorf("invalid %s record address %q", recordType, data[0])
+		}
+
+		opts.Target = data[0]
+	case RecordTypeNS, RecordTypeCNAME, RecordTypePTR:
+		if err := args(1); err != nil {
+			return opts, err
+		}
+
+		opts.Target = zoneFileName(data[0], origin)
+	case RecordTypeMX:
+		if err := args(2); err != nil {
+			return opts, err
+		}
+
+		priority, err := strconv.Atoi(data[0])
+		if err != nil {
+			return opts, fmt.Errorf("invalid MX record priority %q", data[0])
+		}
+
+		opts.Priority = &priority
+		opts.Target = zoneFileName(data[1], origin)
+	case RecordTypeTXT:
+		if len(data) == 0 {
+			return opts, errors.New("TXT records require a value")
+		}
+
+		opts.Target = strings.Join(data, "")
+	case RecordTypeSRV:
+		if err := args(4); err != nil {
+			return opts, err
+		}
+
+		// The owner of an SRV record is _service._protocol[.name]
+		labels := strings.SplitN(name, ".", 3)
+		if len(labels) < 2 || !strings.HasPrefix(labels[0], "_") || !strings.HasPrefix(labels[1], "_") {
+			return opts, fmt.Errorf("invalid SRV record name %q, must start with _service._protocol", name)
+		}
+
+		service := strings.TrimPrefix(labels[0], "_")
+		protocol := strings.TrimPrefix(labels[1], "_")
+		opts.Service = &service
+		opts.Protocol = &protocol
+		opts.Name = ""
+
+		if len(labels) == 3 {
+			opts.Name = labels[2]
+		}
+
+		values := make([]int, 3)
+		for i, field := range []string{"priority", "weight", "port"} {
+			value, err := strconv.Atoi(data[i])
+			if err != nil {
+				return opts, fmt.Errorf("invalid SRV record %s %q", field, data[i])
+			}
+
+			values[i] = value
+		}
+
+		opts.Priority, opts.Weight, opts.Port = &values[0], &values[1], &values[2]
+		opts.Target = zoneFileName(data[3], origin)
+	case RecordTypeCAA:
+		if err := args(3); err != nil {
+			return opts, err
+		}
+
+		// Linode does not support CAA flags, so they are ignored
+		tag := strings.ToLower(data[1])
+		opts.Tag = &tag
+		opts.Target = data[2]
+	default:
+		return opts, fmt.Errorf("unsupported record type %s", recordType)
+	}
+
+	return opts, nil
+}
+
+// zoneFileName returns the fully qualified form of a name in a zone file, without the trailing dot
+func zoneFileName(name, origin string) string {
+	name = strings.ToLower(name)
+
+	switch {
+	case name == "@":
+		return origin
+	case strings.HasSuffix(name, "."):
+		return strings.TrimSuffix(name, ".")
+	case origin == "":
+		return name
+	default:
+		return name + "." + origin
+	}
+}
+
+// zoneFileRelativeName returns the name of a Domain Record for a fully qualified name
+func zoneFileRelativeName(name, domain string) (string, error) {
+	if name == domain {
+		return "", nil
+	}
+
+	if !strings.HasSuffix(name, "."+domain) {
+		return "", fmt.Errorf("name %s is outside of the domain %s", name, domain)
+	}
+
+	return strings.TrimSuffix(name, "."+domain), nil
+}
+
+func isZoneFileClass(token string) bool {
+	switch strings.ToUpper(token) {
+	case "IN", "CH", "HS", "CS":
+		return true
+	}
+
+	return false
+}
+
+// parseZoneFileTTL parses a TTL in seconds or with BIND units, e.g. 3600 or 1h30m
+func parseZoneFileTTL(token string) (int, error) {
+	if ttl, err := strconv.Atoi(token); err == nil && ttl >= 0 {
+		return ttl, nil
+	}
+
+	units := map[byte]int{'s': 1, 'm': 60, 'h': 3600, 'd': 86400, 'w': 604800}
+	ttl, value, digits := 0, 0, 0
+
+	for i := 0; i < len(token); i++ {
+		c := token[i]
+
+		if c >= '0' && c <= '9' {
+			value = value*10 + int(c-'0')
+			digits++
+
+			continue
+		}
+
+		unit, ok := units[c|0x20]
+		if !ok || digits == 0 {
+			return 0, fmt.Errorf("invalid TTL %q", token)
+		}
+
+		ttl += value * unit
+		value, digits = 0, 0
+	}
+
+	if digits > 0 || token == "" {
+		return 0, fmt.Errorf("invalid TTL %q", token)
+	}
+
+	return ttl, nil
+}
+
+// splitZoneFile splits a zone file into entries, removing comments and joining
+// entries spanning multiple lines in parentheses. Quoted strings are unquoted.
+func splitZoneFile(zone string) []zoneFileEntry {
+	var (
+		entries []zoneFileEntry
+		entry   zoneFileEntry
+		token   strings.Builder
+		text    strings.Builder
+
+		inToken, inQuotes, inComment bool
+		depth                        int
+	)
+
+	line := 1
+	lineStart := true
+
+	endToken := func() {
+		if inToken {
+			entry.tokens = append(entry.tokens, token.String())
+			token.Reset()
+
+			inToken = false
+		}
+	}
+
+	endEntry := func() {
+		endToken()
+
+		if len(entry.tokens) > 0 {
+			entry.text = strings.Join(strings.Fields(text.String()), " ")
+			entries = append(entries, entry)
+		}
+
+		entry = zoneFileEntry{}
+		text.Reset()
+	}
+
+	for i := 0; i < len(zone); i++ {
+		c := zone[i]
+
+		if lineStart && depth == 0 && !inQuotes {
+			entry.line = line
+			entry.blankOwner = c == ' ' || c == '\t'
+		}
+
+		lineStart = false
+
+		if c == '\n' {
+			line++
+			lineStart = true
+			inComment = false
+
+			if inQuotes {
+				token.WriteByte(c)
+				continue
+			}
+
+			if depth == 0 {
+				endEntry()
+			} else {
+				endToken()
+				text.WriteByte(' ')
+			}
+
+			continue
+		}
+
+		if inComment {
+			continue
+		}
+
+		if c == ';' && !inQuotes {
+			endToken()
+
+			inComment = true
+
+			continue
+		}
+
+		text.WriteByte(c)
+
+		switch {
+		case inQuotes && c == '\\' && i+1 < len(zone):
+			i++
+			token.WriteByte(zone[i])
+			text.WriteByte(zone[i])
+		case c == '"':
+			inQuotes = !inQuotes
+			inToken = true
+		case inQuotes:
+			token.WriteByte(c)
+		case c == '(':
+			endToken()
+			depth++
+		case c == ')':
+			endToken()
+
+			if depth > 0 {
+				depth--
+			}
+		case c == ' ' || c == '\t' || c == '\r':
+			endToken()
+		default:
+			token.WriteByte(c)
+			inToken = true
+		}
+	}
+
+	endEntry()
+
+	return entries
+}
@@ -0,0 +1,154 @@
+package linodego
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+const testZoneFile = `$ORIGIN example.com.
+$TTL 3600
+@       IN  SOA ns1.linode.com. admin.example.com. (
+            2024010101 ; serial
+            14400 14400 1209600 86400 )
+@           NS  ns1.linode.com.
+@           A   192.0.2.1
+www     300 IN  CNAME @
+            A   192.0.2.2 ; invalid next to a CNAME, but parses
+@           MX  10 mail
+mail        AAAA 2001:db8::1
+@           TXT "v=spf1 " "include:example.net -all"
+_sip._tcp   SRV 10 20 5060 sip.example.com.
+@           CAA 0 issue "letsencrypt.org"
+bad         A   not-an-ip
+other.org.  A   192.0.2.3
+`
+
+func TestParseZoneFile(t *testing.T) {
+	records, errs := parseZoneFile(testZoneFile, "example.com")
+
+	if len(errs) != 2 || errs[0].Line != 15 || errs[1].Line != 16 {
+		t.Errorf("expected errors on lines 15 and 16, got %v", errs)
+	}
+
+	if len(records) != 8 {
+		t.Fatalf("expected 8 records, got %d", len(records))
+	}
+
+	cname := records[1].opts
+	if cname.Type != RecordTypeCNAME || cname.Name != "www" || cname.Target != "example.com" || cname.TTLSec != 300 {
+		t.Errorf("unexpected CNAME record: %+v", cname)
+	}
+
+	if a := records[2].opts; a.Name != "www" || a.TTLSec != 3600 {
+		t.Errorf("expected the owner and TTL of the previous record to be used, got %+v", a)
+	}
+
+	if mx := records[3].opts; intValue(mx.Priority) != 10 || mx.Target != "mail.example.com" {
+		t.Errorf("unexpected MX record: %+v", mx)
+	}
+
+	if txt := records[5].opts; txt.Target != "v=spf1 include:example.net -all" {
+		t.Errorf("unexpected TXT record: %q", txt.Target)
+	}
+
+	srv := records[6].opts
+	if stringValue(srv.Service) != "sip" || stringValue(srv.Protocol) != "tcp" || srv.Name != "" ||
+		intValue(srv.Weight) != 20 || intValue(srv.Port) != 5060 {
+		t.Errorf("unexpected SRV record: %+v", srv)
+	}
+
+	if caa := records[7].opts; stringValue(caa.Tag) != "issue" || caa.Target != "letsencrypt.org" {
+		t.Errorf("unexpected CAA record: %+v", caa)
+	}
+}
+
+func TestParseZoneFileTTL(t *testing.T) {
+	for token, want := range map[string]int{"300": 300, "1h30m": 5400, "1W": 604800, "2d": 172800} {
+		if got, err := parseZoneFileTTL(token); err != nil || got != want {
+			t.Errorf("expected %s to be %d, got %d (%v)", token, want, got, err)
+		}
+	}
+
+	for _, token := range []string{"", "h", "10x", "MX", "1h5"} {
+		if _, err := parseZoneFileTTL(token); err == nil {
+			t.Errorf("expected %q to be invalid", token)
+		}
+	}
+}
+
+func TestClient_ImportDomainRecordsFromZoneFile(t *testing.T) {
+	var created, updated []DomainRecordCreateOptions
+
+	ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Set("Content-Type", "application/json")
+
+		switch {
+		case r.URL.Path == "/v4/domains/1":
+			rw.Write([]byte(`{"id": 1, "domain": "example.com"}`))
+		case r.URL.Path == "/v4/domains/1/records" && r.Method == http.MethodGet:
+			rw.Write([]byte(`{"data": [
+				{"id": 10, "type": "A", "name": "", "target": "192.0.2.1", "ttl_sec": 3600},
+				{"id": 11, "type": "A", "name": "www", "target": "192.0.2.9", "ttl_sec": 3600},
+				{"id": 12, "type": "TXT", "name": "old", "target": "kept", "ttl_sec": 0}
+			], "page": 1, "pages": 1, "results": 3}`))
+		case r.URL.Path == "/v4/domains/1/records" && r.Method == http.MethodPost:
+			var opts DomainRecordCreateOptions
+			json.NewDecoder(r.Body).Decode(&opts)
+
+			if opts.Type == RecordTypeTXT {
+				rw.WriteHeader(http.StatusBadRequest)
+				rw.Write([]byte(`{"errors": [{"reason": "Invalid target"}]}`))
+
+				return
+			}
+
+			created = append(created, opts)
+			json.NewEncoder(rw).Encode(DomainRecord{ID: 20, Type: opts.Type, Name: opts.Name, Target: opts.Target})
+		case r.URL.Path == "/v4/domains/1/records/11" && r.Method == http.MethodPut:
+			var opts DomainRecordCreateOptions
+			json.NewDecoder(r.Body).Decode(&opts)
+
+			updated = append(updated, opts)
+			json.NewEncoder(rw).Encode(DomainRecord{ID: 11, Type: opts.Type, Name: opts.Name, Target: opts.Target})
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			rw.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	client := NewClient(nil)
+	client.SetBaseURL(ts.URL)
+
+	zone := strings.Join([]string{
+		"$TTL 1h",
+		"@   A   192.0.2.1",
+		"www A   192.0.2.2",
+		"ftp CNAME www",
+		`@   TXT "rejected"`,
+	}, "\n")
+
+	result, err := client.ImportDomainRecordsFromZoneFile(context.Background(), 1, zone)
+
+	var apiErr *Error
+	if !errors.As(err, &apiErr) || len(result.Errors) != 1 || result.Errors[0].Line != 5 {
+		t.Fatalf("expected an import error for the TXT record, got %v", err)
+	}
+
+	if len(result.Unchanged) != 1 || result.Unchanged[0].ID != 10 {
+		t.Errorf("expected the apex record to be unchanged, got %v", result.Unchanged)
+	}
+
+	if len(updated) != 1 || updated[0].Target != "192.0.2.2" {
+		t.Errorf("expected the www record to be updated, got %v", updated)
+	}
+
+	if len(created) != 1 || created[0].Type != RecordTypeCNAME || created[0].Target != "www.example.com" {
+		t.Errorf("expected the CNAME record to be created, got %v", created)
+	}
+}
@@ -2,7 +2,6 @@ package linodego
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 
 	"github.com/go-resty/resty/v2"
@@ -234,7 +233,7 @@ func (c *Client) GetDomain(ctx context.Context, domainID int) (*Domain, error) {
 
 // CreateDomain creates a Domain
 func (c *Client) CreateDomain(ctx context.Context, opts DomainCreateOptions) (*Domain, error) {
-	body, err := json.Marshal(opts)
+	body, err := c.marshalJSON(opts)
 	if err != nil {
 		return nil, err
 	}
@@ -251,7 +250,7 @@ func (c *Client) CreateDomain(ctx context.Context, opts DomainCreateOptions) (*D
 
 // UpdateDomain updates the Domain with the specified id
 func (c *Client) UpdateDomain(ctx context.Context, domainID int, opts DomainUpdateOptions) (*Domain, error) {
-	body, err := json.Marshal(opts)
+	body, err := c.marshalJSON(opts)
 	if err != nil {
 		return nil, err
 	}
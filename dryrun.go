@@ -0,0 +1,85 @@
+package linodego
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"strings"
+	"sync/atomic"
+)
+
+// dryRunExemptContextKey marks the context of requests that do not change any resources, such as
+// POSTs creating short-lived tokens or querying metrics, so that they are sent in dry run mode.
+type dryRunExemptContextKey struct{}
+
+// dryRunTransport logs requests that are not GET or HEAD requests instead of sending them
+// while dry run mode is enabled, responding with an empty JSON object.
+type dryRunTransport struct {
+	next    http.RoundTripper
+	enabled *atomic.Bool
+	logger  *clientLogger
+}
+
+func (t *dryRunTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !t.enabled.Load() || req.Method == http.MethodGet || req.Method == http.MethodHead ||
+		req.Context().Value(dryRunExemptContextKey{}) != nil {
+		return t.next.RoundTrip(req)
+	}
+
+	var body []byte
+
+	if req.Body != nil {
+		var err error
+		if body, err = io.ReadAll(req.Body); err != nil {
+			return nil, err
+		}
+
+		req.Body.Close()
+	}
+
+	t.logger.Warnf("[DRY RUN] %s %s %s", req.Method, req.URL, bytes.TrimSpace(body))
+
+	return &http.Response{
+		Status:        "200 OK",
+		StatusCode:    http.StatusOK,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{"Content-Type": {"application/json"}},
+		Body:          io.NopCloser(strings.NewReader("{}")),
+		ContentLength: 2,
+		Request:       req,
+	}, nil
+}
+
+// SetDryRun sets whether requests that are not GET requests are sent. While dry run mode is enabled,
+// these requests are logged with their method, URL and body as a warning and are not sent to the API,
+// and GET requests are made as usual. The logged bodies may contain secrets such as root passwords.
+//
+// Methods making requests that are not sent return no error and a fabricated zero-value object,
+// e.g. CreateInstance returns an Instance without an ID. Read-only POST requests such as those made by
+// QueryMonitorMetrics are sent as usual. Callers must not depend on the contents
+// of returned objects, and wait helpers polling for the result of such a request will not complete.
+// SetProxy and SetRootCertificate must be called before enabling dry run mode.
+func (c *Client) SetDryRun(enabled bool) *Client {
+	if c.dryRun == nil {
+		c.dryRun = &atomic.Bool{}
+		c.wrapDryRunTransport()
+	}
+
+	c.dryRun.Store(enabled)
+
+	return c
+}
+
+// wrapDryRunTransport wraps the transport of the underlying HTTP client with the dry run transport
+func (c *Client) wrapDryRunTransport() {
+	httpClient := c.resty.GetClient()
+
+	next := httpClient.Transport
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	httpClient.Transport = &dryRunTransport{next: next, enabled: c.dryRun, logger: c.logger}
+}
@@ -0,0 +1,64 @@
+package linodego
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestClient_SetDryRun(t *testing.T) {
+	var requests []string
+
+	ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(`{"id": 123, "label": "real"}`))
+	}))
+	defer ts.Close()
+
+	logger := &testLogger{}
+
+	client := NewClient(nil)
+	client.SetBaseURL(ts.URL).SetLogger(logger)
+	client.SetDryRun(true)
+
+	instance, err := client.CreateInstance(context.Background(), InstanceCreateOptions{Region: "us-east", Type: "g6-nanode-1", Label: "planned"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if instance.ID != 0 {
+		t.Errorf("expected a zero-value instance, got %v", instance)
+	}
+
+	if err := client.DeleteInstance(context.Background(), 123); err != nil {
+		t.Fatal(err)
+	}
+
+	if instance, err = client.GetInstance(context.Background(), 123); err != nil || instance.Label != "real" {
+		t.Fatalf("expected GET requests to be sent, got %v (%v)", instance, err)
+	}
+
+	if len(requests) != 1 || requests[0] != "GET /v4/linode/instances/123" {
+		t.Errorf("expected only the GET request to be sent, got %v", requests)
+	}
+
+	if len(logger.messages) != 2 ||
+		!strings.HasPrefix(logger.messages[0], "[DRY RUN] POST "+ts.URL+"/v4/linode/instances ") ||
+		!strings.Contains(logger.messages[0], `"label":"planned"`) {
+		t.Errorf("expected the skipped requests to be logged, got %v", logger.messages)
+	}
+
+	client.SetDryRun(false)
+
+	if _, err := client.CreateInstance(context.Background(), InstanceCreateOptions{Region: "us-east", Type: "g6-nanode-1"}); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(requests) != 2 {
+		t.Errorf("expected requests to be sent once dry run mode is disabled, got %v", requests)
+	}
+}
@@ -20,11 +20,36 @@ const (
 	ErrorFromStringer
 )
 
+var (
+	// ErrNotFound matches errors returned for resources that do not exist.
+	ErrNotFound = &Error{Code: http.StatusNotFound, Message: http.StatusText(http.StatusNotFound)}
+
+	// ErrRateLimited matches errors returned when the request was rate limited by the API.
+	ErrRateLimited = &Error{Code: http.StatusTooManyRequests, Message: http.StatusText(http.StatusTooManyRequests)}
+
+	// ErrConflict matches errors returned when a conditional update failed because the resource has changed.
+	ErrConflict = &Error{Code: http.StatusConflict, Message: http.StatusText(http.StatusConflict)}
+
+	// ErrMaintenance matches errors returned while the Linode API is under maintenance.
+	// Unlike other 503 responses, these responses include the X-Maintenance-Mode header.
+	ErrMaintenance = &Error{Code: http.StatusServiceUnavailable, Message: "Linode API is under maintenance"}
+
+	// ErrAlreadyExists is returned along with the existing resource by idempotent create functions,
+	// e.g. CreateInstanceWithIdempotency, when the resource was already created with the same key.
+	ErrAlreadyExists = errors.New("resource already exists")
+
+	// ErrClientClosed is returned for requests made after the Client was closed, see Client.Close.
+	ErrClientClosed = &Error{Code: ErrorFromError, Message: "client is closed"}
+)
+
 // Error wraps the LinodeGo error with the relevant http.Response
 type Error struct {
 	Response *http.Response
 	Code     int
 	Message  string
+
+	// APIError contains the structured error returned by the Linode API, if any.
+	APIError *APIError
 }
 
 // APIErrorReason is an individual invalid request message returned by the Linode API
@@ -44,12 +69,18 @@ func (r APIErrorReason) Error() string {
 // APIError is the error-set returned by the Linode API when presented with an invalid request
 type APIError struct {
 	Errors []APIErrorReason `json:"errors"`
+
+	// RequestID is the X-Request-ID returned by the API, or sent with the request if the API returned none
+	RequestID string `json:"-"`
+
+	statusCode int
 }
 
 func coupleAPIErrors(r *resty.Response, err error) (*resty.Response, error) {
 	if err != nil {
 		// an error was raised in go code, no need to check the resty Response
-		return nil, NewError(err)
+		// unless the error was raised while decoding it
+		return nil, NewError(decodingError(r, err))
 	}
 
 	if r.Error() == nil {
@@ -98,6 +129,37 @@ func (e APIError) Error() string {
 	return strings.Join(x, "; ")
 }
 
+// HTTPStatus returns the HTTP status code of the response the APIError was
+// returned with, or 0 if it is unknown.
+func (e APIError) HTTPStatus() int {
+	return e.statusCode
+}
+
+// Is reports whether target has the same HTTP status code as the APIError.
+func (e APIError) Is(target error) bool {
+	// Maintenance is determined by the response headers, see Error.Is
+	if target == ErrMaintenance {
+		return false
+	}
+
+	if x, ok := target.(interface{ StatusCode() int }); ok {
+		return e.statusCode != 0 && e.statusCode == x.StatusCode()
+	}
+
+	return false
+}
+
+// Unwrap returns the individual APIErrorReasons of the APIError,
+// allowing them to be extracted with errors.As.
+func (e APIError) Unwrap() []error {
+	reasons := make([]error, len(e.Errors))
+	for i, reason := range e.Errors {
+		reasons[i] = reason
+	}
+
+	return reasons
+}
+
 func (err Error) Error() string {
 	return fmt.Sprintf("[%03d] %s", err.Code, err.Message)
 }
@@ -107,6 +169,16 @@ func (err Error) StatusCode() int {
 }
 
 func (err Error) Is(target error) bool {
+	if target == ErrMaintenance {
+		return err.Code == http.StatusServiceUnavailable &&
+			err.Response != nil &&
+			err.Response.Header.Get(maintenanceModeHeaderName) != ""
+	}
+
+	if target == ErrClientClosed {
+		return err.Code == ErrClientClosed.Code && err.Message == ErrClientClosed.Message
+	}
+
 	if x, ok := target.(interface{ StatusCode() int }); ok || errors.As(target, &x) {
 		return err.StatusCode() == x.StatusCode()
 	}
@@ -114,6 +186,31 @@ func (err Error) Is(target error) bool {
 	return false
 }
 
+// Unwrap returns the structured APIError returned by the Linode API, if any.
+func (err Error) Unwrap() error {
+	if err.APIError == nil {
+		return nil
+	}
+
+	return err.APIError
+}
+
+// IsNotFound reports whether err indicates that the requested resource does not exist (HTTP 404).
+func IsNotFound(err error) bool {
+	return errors.Is(err, ErrNotFound)
+}
+
+// IsRateLimited reports whether err indicates that the request was rate limited (HTTP 429).
+func IsRateLimited(err error) bool {
+	return errors.Is(err, ErrRateLimited)
+}
+
+// IsUnderMaintenance reports whether err was returned while the Linode API is under maintenance,
+// as indicated by a 503 response with the X-Maintenance-Mode header.
+func IsUnderMaintenance(err error) bool {
+	return errors.Is(err, ErrMaintenance)
+}
+
 // NewError creates a linodego.Error with a Code identifying the source err type,
 // - ErrorFromString   (1) from a string
 // - ErrorFromError    (2) for an error
@@ -134,10 +231,14 @@ func NewError(err any) *Error {
 			return &Error{Code: ErrorUnsupported, Message: "Unexpected Resty Error Response, no error"}
 		}
 
+		apiError.statusCode = e.RawResponse.StatusCode
+		apiError.RequestID = responseRequestID(e)
+
 		return &Error{
 			Code:     e.RawResponse.StatusCode,
 			Message:  apiError.Error(),
 			Response: e.RawResponse,
+			APIError: apiError,
 		}
 	case error:
 		return &Error{Code: ErrorFromError, Message: e.Error()}
@@ -294,3 +294,103 @@ func TestErrorIs(t *testing.T) {
 		})
 	}
 }
+
+func TestErrorSentinels(t *testing.T) {
+	newResponseError := func(statusCode int, header http.Header) error {
+		return NewError(&resty.Response{
+			RawResponse: &http.Response{
+				StatusCode: statusCode,
+				Header:     header,
+			},
+			Request: &resty.Request{
+				Error: &APIError{
+					Errors: []APIErrorReason{{Reason: "test reason", Field: "test_field"}},
+				},
+			},
+		})
+	}
+
+	notFound := newResponseError(http.StatusNotFound, http.Header{})
+	rateLimited := newResponseError(http.StatusTooManyRequests, http.Header{})
+	unavailable := newResponseError(http.StatusServiceUnavailable, http.Header{})
+	maintenance := newResponseError(http.StatusServiceUnavailable, http.Header{
+		maintenanceModeHeaderName: []string{"Currently in maintenance mode."},
+	})
+
+	for _, tc := range []struct {
+		testName string
+		err      error
+		target   error
+		expected bool
+	}{
+		{"not found", notFound, ErrNotFound, true},
+		{"wrapped not found", fmt.Errorf("wrapped: %w", notFound), ErrNotFound, true},
+		{"not found is not rate limited", notFound, ErrRateLimited, false},
+		{"rate limited", rateLimited, ErrRateLimited, true},
+		{"maintenance", maintenance, ErrMaintenance, true},
+		{"service unavailable is not maintenance", unavailable, ErrMaintenance, false},
+		{"go error is not found", errors.New("not found"), ErrNotFound, false},
+	} {
+		t.Run(tc.testName, func(t *testing.T) {
+			if errors.Is(tc.err, tc.target) != tc.expected {
+				t.Errorf("expected errors.Is(%v, %v) to be %t", tc.err, tc.target, tc.expected)
+			}
+		})
+	}
+
+	if notFound.Error() != "[404] [test_field] test reason" {
+		t.Errorf("unexpected error string: %s", notFound.Error())
+	}
+
+	var apiError *APIError
+	if !errors.As(notFound, &apiError) {
+		t.Fatal("expected APIError to be extracted with errors.As")
+	}
+
+	if apiError.HTTPStatus() != http.StatusNotFound {
+		t.Errorf("expected HTTP status 404 but got %d", apiError.HTTPStatus())
+	}
+
+	var reason APIErrorReason
+	if !errors.As(notFound, &reason) || reason.Field != "test_field" {
+		t.Errorf("expected APIErrorReason to be extracted with errors.As, got %v", reason)
+	}
+}
+
+func TestErrorHelpers(t *testing.T) {
+	maintenanceResponse := &http.Response{
+		StatusCode: http.StatusServiceUnavailable,
+		Header:     http.Header{maintenanceModeHeaderName: []string{"Currently in maintenance mode."}},
+	}
+
+	if !IsNotFound(&Error{Code: http.StatusNotFound}) {
+		t.Error("expected *Error with code 404 to be not found")
+	}
+
+	if !IsNotFound(Error{Code: http.StatusNotFound}) {
+		t.Error("expected Error with code 404 to be not found")
+	}
+
+	notFoundResponse := restyError("Not found", "id")
+	notFoundResponse.RawResponse.StatusCode = http.StatusNotFound
+
+	if !IsNotFound(fmt.Errorf("wrapped: %w", NewError(notFoundResponse))) {
+		t.Error("expected wrapped API error with code 404 to be not found")
+	}
+
+	if IsNotFound(errors.New("not found")) || IsNotFound(nil) {
+		t.Error("expected non-API errors not to be not found")
+	}
+
+	if !IsRateLimited(&Error{Code: http.StatusTooManyRequests}) || IsRateLimited(&Error{Code: http.StatusNotFound}) {
+		t.Error("expected only 429 errors to be rate limited")
+	}
+
+	if !IsUnderMaintenance(&Error{Code: http.StatusServiceUnavailable, Response: maintenanceResponse}) {
+		t.Error("expected 503 with maintenance header to be under maintenance")
+	}
+
+	if IsUnderMaintenance(&Error{Code: http.StatusServiceUnavailable, Response: &http.Response{Header: http.Header{}}}) {
+		t.Error("expected 503 without maintenance header not to be under maintenance")
+	}
+}
@@ -0,0 +1,132 @@
+package linodego
+
+import (
+	"net/http"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/go-resty/resty/v2"
+)
+
+const (
+	etagHeaderName        = "ETag"
+	ifNoneMatchHeaderName = "If-None-Match"
+)
+
+type etagEntry struct {
+	etag   string
+	result reflect.Value
+}
+
+// etagStore holds the ETags and deserialized results of previous GET responses.
+type etagStore struct {
+	mu      sync.RWMutex
+	enabled bool
+	entries map[string]etagEntry
+}
+
+func newETagStore() *etagStore {
+	return &etagStore{entries: make(map[string]etagEntry)}
+}
+
+func (s *etagStore) setEnabled(enabled bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.enabled = enabled
+
+	if !enabled {
+		s.entries = make(map[string]etagEntry)
+	}
+}
+
+func (s *etagStore) get(key string) (etagEntry, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if !s.enabled {
+		return etagEntry{}, false
+	}
+
+	entry, ok := s.entries[key]
+
+	return entry, ok
+}
+
+func (s *etagStore) set(key string, entry etagEntry) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.enabled {
+		s.entries[key] = entry
+	}
+}
+
+// etagKey returns the key identifying the resource requested by req,
+// both before and after resty resolved its URL against the base URL.
+func etagKey(rc *resty.Client, req *resty.Request) string {
+	endpoint, _, _ := strings.Cut(strings.TrimPrefix(req.URL, rc.BaseURL), "?")
+
+	return strings.Trim(endpoint, "/") + "?" + req.QueryParam.Encode() + " " + req.Header.Get("X-Filter")
+}
+
+// UseConditionalRequests sets whether GET requests should send the ETag of a previous
+// response for the same resource using the If-None-Match header. When the API responds
+// with 304 Not Modified, the previously deserialized result is returned instead.
+// Conditional requests are disabled by default.
+func (c *Client) UseConditionalRequests(value bool) *Client {
+	if c.etags == nil {
+		store := newETagStore()
+		c.etags = store
+
+		c.resty.OnBeforeRequest(func(rc *resty.Client, req *resty.Request) error {
+			if req.Method != http.MethodGet {
+				return nil
+			}
+
+			if entry, ok := store.get(etagKey(rc, req)); ok {
+				req.SetHeader(ifNoneMatchHeaderName, entry.etag)
+			}
+
+			return nil
+		})
+
+		c.resty.OnAfterResponse(func(rc *resty.Client, resp *resty.Response) error {
+			req := resp.Request
+			if req.Method != http.MethodGet || req.Result == nil {
+				return nil
+			}
+
+			result := reflect.ValueOf(req.Result)
+			if result.Kind() != reflect.Ptr {
+				return nil
+			}
+
+			key := etagKey(rc, req)
+
+			switch {
+			case resp.StatusCode() == http.StatusNotModified:
+				entry, ok := store.get(key)
+				if !ok || entry.result.Type() != result.Elem().Type() {
+					return nil
+				}
+
+				// The body of a 304 response is empty, so populate the result
+				// with the previously deserialized value instead.
+				result.Elem().Set(entry.result)
+				req.Error = nil
+			case resp.IsSuccess():
+				if etag := resp.Header().Get(etagHeaderName); etag != "" {
+					store.set(key, etagEntry{etag: etag, result: reflect.ValueOf(result.Elem().Interface())})
+				}
+			}
+
+			return nil
+		})
+	}
+
+	c.etags.setEnabled(value)
+
+	return c
+}
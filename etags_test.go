@@ -0,0 +1,72 @@
+package linodego
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestClient_UseConditionalRequests(t *testing.T) {
+	var requests, notModified int
+
+	ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		requests++
+
+		if r.Header.Get(ifNoneMatchHeaderName) == `"v1"` {
+			notModified++
+			rw.WriteHeader(http.StatusNotModified)
+
+			return
+		}
+
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Header().Set(etagHeaderName, `"v1"`)
+		rw.Write([]byte(`{"id": 123, "label": "test", "tags": ["foo"]}`))
+	}))
+	defer ts.Close()
+
+	client := NewClient(nil)
+	client.SetBaseURL(ts.URL)
+
+	getVolume := func() *Volume {
+		t.Helper()
+
+		volume, err := client.GetVolume(context.Background(), 123)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return volume
+	}
+
+	// Conditional requests are disabled by default
+	getVolume()
+	getVolume()
+
+	if notModified != 0 {
+		t.Fatalf("expected no conditional requests by default, got %d", notModified)
+	}
+
+	client.UseConditionalRequests(true)
+
+	getVolume()
+
+	for i := 0; i < 2; i++ {
+		volume := getVolume()
+		if volume.ID != 123 || volume.Label != "test" || len(volume.Tags) != 1 {
+			t.Fatalf("expected the previous result to be returned for a 304 response, got %#v", volume)
+		}
+	}
+
+	if requests != 5 || notModified != 2 {
+		t.Fatalf("expected 5 requests with 2 not modified responses, got %d and %d", requests, notModified)
+	}
+
+	client.UseConditionalRequests(false)
+	getVolume()
+
+	if notModified != 2 {
+		t.Fatalf("expected no conditional requests after disabling, got %d", notModified-2)
+	}
+}
@@ -2,8 +2,12 @@ package linodego
 
 import (
 	"encoding/json"
+	"time"
 )
 
+// filterTimeLayout is the layout of the time.Time values of filters, which are compared in UTC
+const filterTimeLayout = "2006-01-02T15:04:05"
+
 type FilterOperator string
 
 const (
@@ -33,10 +37,111 @@ type Filter struct {
 	Order string
 }
 
+// NewFilter returns an empty Filter to be built using its fluent methods, e.g.:
+//
+//	f := linodego.NewFilter().Eq("status", "running").Contains("label", "web").SetOrder("label", linodego.Ascending)
+//
+// time.Time values are compared in UTC.
+func NewFilter() *Filter {
+	return &Filter{}
+}
+
 func (f *Filter) AddField(op FilterOperator, key string, value any) {
 	f.Children = append(f.Children, &Comp{key, op, value})
 }
 
+// Eq adds a field that must be equal to value.
+func (f *Filter) Eq(key string, value any) *Filter {
+	f.AddField(Eq, key, value)
+	return f
+}
+
+// Neq adds a field that must not be equal to value.
+func (f *Filter) Neq(key string, value any) *Filter {
+	f.AddField(Neq, key, value)
+	return f
+}
+
+// Gt adds a field that must be greater than value.
+func (f *Filter) Gt(key string, value any) *Filter {
+	f.AddField(Gt, key, value)
+	return f
+}
+
+// Gte adds a field that must be greater than or equal to value.
+func (f *Filter) Gte(key string, value any) *Filter {
+	f.AddField(Gte, key, value)
+	return f
+}
+
+// Lt adds a field that must be less than value.
+func (f *Filter) Lt(key string, value any) *Filter {
+	f.AddField(Lt, key, value)
+	return f
+}
+
+// Lte adds a field that must be less than or equal to value.
+func (f *Filter) Lte(key string, value any) *Filter {
+	f.AddField(Lte, key, value)
+	return f
+}
+
+// Contains adds a field that must contain substr.
+func (f *Filter) Contains(key string, substr string) *Filter {
+	f.AddField(Contains, key, substr)
+	return f
+}
+
+// And adds a group of nodes that must all match.
+func (f *Filter) And(nodes ...FilterNode) *Filter {
+	f.Children = append(f.Children, &Filter{Operator: "+and", Children: nodes})
+	return f
+}
+
+// Or adds a group of nodes of which at least one must match.
+func (f *Filter) Or(nodes ...FilterNode) *Filter {
+	f.Children = append(f.Children, &Filter{Operator: "+or", Children: nodes})
+	return f
+}
+
+// SetOrder sets the field to order the results by and the direction (Ascending/Descending) to order them in.
+func (f *Filter) SetOrder(orderBy string, order string) *Filter {
+	f.OrderBy = orderBy
+	f.Order = order
+
+	return f
+}
+
+// Key implements FilterNode so that filters can be nested into groups.
+// Filters without an Operator are nested as an "+and" group.
+func (f *Filter) Key() string {
+	if f.Operator != "" {
+		return f.Operator
+	}
+
+	if len(f.Children) == 1 {
+		return f.Children[0].Key()
+	}
+
+	return "+and"
+}
+
+// JSONValueSegment implements FilterNode so that filters can be nested into groups.
+func (f *Filter) JSONValueSegment() any {
+	if f.Operator == "" && len(f.Children) == 1 {
+		return f.Children[0].JSONValueSegment()
+	}
+
+	fields := make([]map[string]any, len(f.Children))
+	for i, c := range f.Children {
+		fields[i] = map[string]any{
+			c.Key(): c.JSONValueSegment(),
+		}
+	}
+
+	return fields
+}
+
 func (f *Filter) MarshalJSON() ([]byte, error) {
 	result := make(map[string]any)
 
@@ -48,7 +153,7 @@ func (f *Filter) MarshalJSON() ([]byte, error) {
 		result["+order"] = f.Order
 	}
 
-	if f.Operator == "" {
+	if f.Operator == "" && !f.hasDuplicateKeys() {
 		for _, c := range f.Children {
 			result[c.Key()] = c.JSONValueSegment()
 		}
@@ -56,16 +161,36 @@ func (f *Filter) MarshalJSON() ([]byte, error) {
 		return json.Marshal(result)
 	}
 
-	fields := make([]map[string]any, len(f.Children))
-	for i, c := range f.Children {
-		fields[i] = map[string]any{
-			c.Key(): c.JSONValueSegment(),
-		}
+	result[f.Key()] = f.JSONValueSegment()
+
+	return json.Marshal(result)
+}
+
+// String returns the X-Filter JSON representation of the filter,
+// or an empty string if it cannot be marshalled.
+func (f *Filter) String() string {
+	result, err := f.MarshalJSON()
+	if err != nil {
+		return ""
 	}
 
-	result[f.Operator] = fields
+	return string(result)
+}
 
-	return json.Marshal(result)
+// hasDuplicateKeys returns whether multiple children share the same key,
+// in which case they cannot be represented as fields of a single object.
+func (f *Filter) hasDuplicateKeys() bool {
+	keys := make(map[string]struct{}, len(f.Children))
+
+	for _, c := range f.Children {
+		if _, ok := keys[c.Key()]; ok {
+			return true
+		}
+
+		keys[c.Key()] = struct{}{}
+	}
+
+	return false
 }
 
 type Comp struct {
@@ -79,15 +204,32 @@ func (c *Comp) Key() string {
 }
 
 func (c *Comp) JSONValueSegment() any {
+	value := filterValue(c.Value)
+
 	if c.Operator == Eq {
-		return c.Value
+		return value
 	}
 
 	return map[string]any{
-		string(c.Operator): c.Value,
+		string(c.Operator): value,
 	}
 }
 
+// filterValue converts time.Time values to the format accepted by the API,
+// so that time windows can be filtered with e.g. Gte("created", since)
+func filterValue(value any) any {
+	switch v := value.(type) {
+	case time.Time:
+		return v.UTC().Format(filterTimeLayout)
+	case *time.Time:
+		if v != nil {
+			return v.UTC().Format(filterTimeLayout)
+		}
+	}
+
+	return value
+}
+
 func Or(order string, orderBy string, nodes ...FilterNode) *Filter {
 	return &Filter{"+or", nodes, orderBy, order}
 }
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"reflect"
 	"testing"
+	"time"
 )
 
 func TestFilter(t *testing.T) {
@@ -162,3 +163,106 @@ func TestFilterOr(t *testing.T) {
 		t.Fatal(string(result), " doesn't match ", string(expectedStr))
 	}
 }
+
+func TestFilterBuilder(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		filter   *Filter
+		expected map[string]any
+	}{
+		{
+			name:   "fields",
+			filter: NewFilter().Eq("status", "running").Contains("label", "web").Gte("vcpus", 2),
+			expected: map[string]any{
+				"status": "running",
+				"label":  map[string]any{"+contains": "web"},
+				"vcpus":  map[string]any{"+gte": 2},
+			},
+		},
+		{
+			name:   "order",
+			filter: NewFilter().Eq("class", "standard").SetOrder("label", Descending),
+			expected: map[string]any{
+				"class":     "standard",
+				"+order_by": "label",
+				"+order":    "desc",
+			},
+		},
+		{
+			name: "or group",
+			filter: NewFilter().Eq("status", "running").Or(
+				NewFilter().Eq("region", "us-east"),
+				NewFilter().Eq("region", "us-west"),
+			),
+			expected: map[string]any{
+				"status": "running",
+				"+or": []map[string]any{
+					{"region": "us-east"},
+					{"region": "us-west"},
+				},
+			},
+		},
+		{
+			name: "nested groups",
+			filter: NewFilter().Or(
+				NewFilter().And(
+					&Comp{"region", Eq, "us-east"},
+					&Comp{"vcpus", Lt, 4},
+				),
+				NewFilter().Neq("region", "us-east").Lte("vcpus", 8),
+			).SetOrder("vcpus", Ascending),
+			expected: map[string]any{
+				"+order_by": "vcpus",
+				"+order":    "asc",
+				"+or": []map[string]any{
+					{"+and": []map[string]any{
+						{"region": "us-east"},
+						{"vcpus": map[string]any{"+lt": 4}},
+					}},
+					{"+and": []map[string]any{
+						{"region": map[string]any{"+neq": "us-east"}},
+						{"vcpus": map[string]any{"+lte": 8}},
+					}},
+				},
+			},
+		},
+		{
+			name:   "duplicate keys",
+			filter: NewFilter().Gt("vcpus", 2).Lt("vcpus", 8),
+			expected: map[string]any{
+				"+and": []map[string]any{
+					{"vcpus": map[string]any{"+gt": 2}},
+					{"vcpus": map[string]any{"+lt": 8}},
+				},
+			},
+		},
+		{
+			name: "time window",
+			filter: NewFilter().Eq("username", "example").
+				Gte("created", time.Date(2024, 1, 2, 3, 4, 5, 0, time.FixedZone("EST", -5*60*60))).
+				Lt("created", time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC)),
+			expected: map[string]any{
+				"+and": []map[string]any{
+					{"username": "example"},
+					{"created": map[string]any{"+gte": "2024-01-02T08:04:05"}},
+					{"created": map[string]any{"+lt": "2024-01-03T00:00:00"}},
+				},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			expectedStr, err := json.Marshal(tc.expected)
+			if err != nil {
+				t.Fatalf("failed to marshal expected json: %v", err)
+			}
+
+			if result := tc.filter.String(); result != string(expectedStr) {
+				t.Fatal(result, " doesn't match ", string(expectedStr))
+			}
+
+			if opts := NewListOptions(0, tc.filter.String()); opts.Filter != string(expectedStr) {
+				t.Fatalf("expected list options filter %s, got %s", expectedStr, opts.Filter)
+			}
+		})
+	}
+}
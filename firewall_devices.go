@@ -33,6 +33,21 @@ type FirewallDeviceCreateOptions struct {
 	Type FirewallDeviceType `json:"type"`
 }
 
+// Validate checks that the options identify a Linode or NodeBalancer to attach to a Firewall
+func (opts FirewallDeviceCreateOptions) Validate() error {
+	switch opts.Type {
+	case FirewallDeviceLinode, FirewallDeviceNodeBalancer:
+	default:
+		return fmt.Errorf("invalid firewall device type %q, must be %q or %q", opts.Type, FirewallDeviceLinode, FirewallDeviceNodeBalancer)
+	}
+
+	if opts.ID <= 0 {
+		return fmt.Errorf("invalid firewall device ID %d, must be the ID of a %s", opts.ID, opts.Type)
+	}
+
+	return nil
+}
+
 // UnmarshalJSON implements the json.Unmarshaler interface
 func (device *FirewallDevice) UnmarshalJSON(b []byte) error {
 	type Mask FirewallDevice
@@ -105,9 +120,15 @@ func (c *Client) GetFirewallDevice(ctx context.Context, firewallID, deviceID int
 	return r.Result().(*FirewallDevice), nil
 }
 
-// AddFirewallDevice associates a Device with a given Firewall
+// CreateFirewallDevice associates a Device with a given Firewall and returns the association.
+// Use InstanceCreateOptions.FirewallID or NodeBalancerCreateOptions.FirewallID to associate a new
+// Instance or NodeBalancer with a Firewall when it is created, before it accepts any traffic.
 func (c *Client) CreateFirewallDevice(ctx context.Context, firewallID int, opts FirewallDeviceCreateOptions) (*FirewallDevice, error) {
-	body, err := json.Marshal(opts)
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+
+	body, err := c.marshalJSON(opts)
 	if err != nil {
 		return nil, err
 	}
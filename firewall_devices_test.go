@@ -0,0 +1,31 @@
+package linodego
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestClient_CreateFirewallDevice(t *testing.T) {
+	ts, client := createTestServer(http.MethodPost, "/v4/networking/firewalls/123/devices", "application/json",
+		`{"id": 456, "entity": {"id": 789, "type": "linode", "label": "example", "url": "/v4/linode/instances/789"}}`, http.StatusOK)
+	defer ts.Close()
+
+	device, err := client.CreateFirewallDevice(context.Background(), 123, FirewallDeviceCreateOptions{ID: 789, Type: FirewallDeviceLinode})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if device.ID != 456 || device.Entity.ID != 789 || device.Entity.Type != FirewallDeviceLinode {
+		t.Errorf("unexpected device %+v", device)
+	}
+
+	for _, opts := range []FirewallDeviceCreateOptions{
+		{ID: 789, Type: "volume"},
+		{ID: 0, Type: FirewallDeviceNodeBalancer},
+	} {
+		if _, err := client.CreateFirewallDevice(context.Background(), 123, opts); err == nil {
+			t.Errorf("expected an error for %+v", opts)
+		}
+	}
+}
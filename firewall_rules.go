@@ -3,7 +3,9 @@ package linodego
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"net/http"
 )
 
 // NetworkProtocol enum type
@@ -33,12 +35,38 @@ type FirewallRule struct {
 	Addresses   NetworkAddresses `json:"addresses"`
 }
 
+// FirewallRuleDirection selects the inbound or outbound rules of a FirewallRuleSet
+type FirewallRuleDirection string
+
+// FirewallRuleDirection constants start with FirewallRule
+const (
+	FirewallRuleInbound  FirewallRuleDirection = "inbound"
+	FirewallRuleOutbound FirewallRuleDirection = "outbound"
+)
+
 // FirewallRuleSet is a pair of inbound and outbound rules that specify what network traffic should be allowed.
 type FirewallRuleSet struct {
 	Inbound        []FirewallRule `json:"inbound"`
 	InboundPolicy  string         `json:"inbound_policy"`
 	Outbound       []FirewallRule `json:"outbound"`
 	OutboundPolicy string         `json:"outbound_policy"`
+
+	// Fingerprint and Version identify the revision of the rules. They are read-only.
+	Fingerprint string `json:"fingerprint"`
+	Version     int    `json:"version"`
+}
+
+// MarshalJSON implements the json.Marshaler interface, omitting the read-only fields
+func (r FirewallRuleSet) MarshalJSON() ([]byte, error) {
+	type Mask FirewallRuleSet
+
+	return json.Marshal(struct {
+		*Mask
+		Fingerprint string `json:"fingerprint,omitempty"`
+		Version     int    `json:"version,omitempty"`
+	}{
+		Mask: (*Mask)(&r),
+	})
 }
 
 // GetFirewallRules gets the FirewallRuleSet for the given Firewall.
@@ -54,7 +82,7 @@ func (c *Client) GetFirewallRules(ctx context.Context, firewallID int) (*Firewal
 
 // UpdateFirewallRules updates the FirewallRuleSet for the given Firewall
 func (c *Client) UpdateFirewallRules(ctx context.Context, firewallID int, rules FirewallRuleSet) (*FirewallRuleSet, error) {
-	body, err := json.Marshal(rules)
+	body, err := c.marshalJSON(rules)
 	if err != nil {
 		return nil, err
 	}
@@ -67,3 +95,90 @@ func (c *Client) UpdateFirewallRules(ctx context.Context, firewallID int, rules
 	}
 	return r.Result().(*FirewallRuleSet), nil
 }
+
+// UpdateFirewallRulesIfUnchanged updates the FirewallRuleSet for the given Firewall only if its
+// current Version is expectedVersion, as returned by GetFirewallRules. Otherwise an error
+// matching ErrConflict is returned and the rules are left unchanged.
+//
+// The API does not support conditional updates, so the Version is compared before the rules
+// are replaced. A concurrent update made between the comparison and the replacement is
+// not detected and will be overwritten.
+func (c *Client) UpdateFirewallRulesIfUnchanged(
+	ctx context.Context, firewallID int, rules FirewallRuleSet, expectedVersion int,
+) (*FirewallRuleSet, error) {
+	current, err := c.GetFirewallRules(ctx, firewallID)
+	if err != nil {
+		return nil, err
+	}
+
+	if current.Version != expectedVersion {
+		return nil, &Error{
+			Code: http.StatusConflict,
+			Message: fmt.Sprintf(
+				"rules of Firewall %d have changed: expected version %d, got %d",
+				firewallID, expectedVersion, current.Version,
+			),
+		}
+	}
+
+	return c.UpdateFirewallRules(ctx, firewallID, rules)
+}
+
+// UpsertFirewallRuleByLabel replaces the rule with the same Label among the inbound or outbound
+// rules of the given Firewall, or appends it if there is none, leaving all other rules unchanged.
+// An error is returned if the Label is empty or shared by several rules.
+//
+// The rules are read, modified and written back with UpdateFirewallRulesIfUnchanged, so an
+// error matching ErrConflict is returned if they were changed by someone else in the
+// meantime, in which case the upsert can be retried. As the API does not support conditional
+// updates, a change made just before the rules are written may still be overwritten.
+func (c *Client) UpsertFirewallRuleByLabel(
+	ctx context.Context, firewallID int, direction FirewallRuleDirection, rule FirewallRule,
+) (*FirewallRuleSet, error) {
+	if rule.Label == "" {
+		return nil, errors.New("rule label is required")
+	}
+
+	current, err := c.GetFirewallRules(ctx, firewallID)
+	if err != nil {
+		return nil, err
+	}
+
+	rules := *current
+
+	var target *[]FirewallRule
+
+	switch direction {
+	case FirewallRuleInbound:
+		target = &rules.Inbound
+	case FirewallRuleOutbound:
+		target = &rules.Outbound
+	default:
+		return nil, fmt.Errorf("invalid firewall rule direction %q", direction)
+	}
+
+	updated := append([]FirewallRule{}, *target...)
+	index := -1
+
+	for i, existing := range updated {
+		if existing.Label != rule.Label {
+			continue
+		}
+
+		if index >= 0 {
+			return nil, fmt.Errorf("multiple %s rules of Firewall %d are labeled %q", direction, firewallID, rule.Label)
+		}
+
+		index = i
+	}
+
+	if index >= 0 {
+		updated[index] = rule
+	} else {
+		updated = append(updated, rule)
+	}
+
+	*target = updated
+
+	return c.UpdateFirewallRulesIfUnchanged(ctx, firewallID, rules, current.Version)
+}
@@ -0,0 +1,279 @@
+package linodego
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	firewallActionAccept = "ACCEPT"
+	firewallActionDrop   = "DROP"
+
+	// firewallRuleMaxPorts is the maximum number of ports and port ranges of a FirewallRule
+	firewallRuleMaxPorts = 15
+
+	// firewallRuleMaxLabelLength is the maximum length of the label of a FirewallRule
+	firewallRuleMaxLabelLength = 32
+)
+
+// FirewallRuleBuilder builds a FirewallRuleSet, validating ports and addresses client-side.
+// Rules are added to the inbound rules until Outbound is called. The inbound policy
+// defaults to DROP and the outbound policy defaults to ACCEPT.
+// Errors are collected while building and returned by Build.
+type FirewallRuleBuilder struct {
+	rules    FirewallRuleSet
+	outbound bool
+	errs     []error
+}
+
+// NewFirewallRuleBuilder returns a FirewallRuleBuilder for an empty FirewallRuleSet
+func NewFirewallRuleBuilder() *FirewallRuleBuilder {
+	return &FirewallRuleBuilder{
+		rules: FirewallRuleSet{
+			Inbound:        []FirewallRule{},
+			InboundPolicy:  firewallActionDrop,
+			Outbound:       []FirewallRule{},
+			OutboundPolicy: firewallActionAccept,
+		},
+	}
+}
+
+// Inbound adds the following rules and policies to the inbound traffic
+func (b *FirewallRuleBuilder) Inbound() *FirewallRuleBuilder {
+	b.outbound = false
+	return b
+}
+
+// Outbound adds the following rules and policies to the outbound traffic
+func (b *FirewallRuleBuilder) Outbound() *FirewallRuleBuilder {
+	b.outbound = true
+	return b
+}
+
+// AllowTCP accepts TCP traffic on the given ports, e.g. "22" or "80,443,8000-9000".
+// Traffic from (or to, for outbound rules) all addresses is accepted if no addresses are given.
+func (b *FirewallRuleBuilder) AllowTCP(ports string, addresses ...string) *FirewallRuleBuilder {
+	return b.addRule(firewallActionAccept, TCP, ports, addresses)
+}
+
+// AllowUDP accepts UDP traffic on the given ports, see AllowTCP
+func (b *FirewallRuleBuilder) AllowUDP(ports string, addresses ...string) *FirewallRuleBuilder {
+	return b.addRule(firewallActionAccept, UDP, ports, addresses)
+}
+
+// AllowICMP accepts ICMP traffic, see AllowTCP
+func (b *FirewallRuleBuilder) AllowICMP(addresses ...string) *FirewallRuleBuilder {
+	return b.addRule(firewallActionAccept, ICMP, "", addresses)
+}
+
+// DenyTCP drops TCP traffic on the given ports, see AllowTCP
+func (b *FirewallRuleBuilder) DenyTCP(ports string, addresses ...string) *FirewallRuleBuilder {
+	return b.addRule(firewallActionDrop, TCP, ports, addresses)
+}
+
+// DenyUDP drops UDP traffic on the given ports, see AllowTCP
+func (b *FirewallRuleBuilder) DenyUDP(ports string, addresses ...string) *FirewallRuleBuilder {
+	return b.addRule(firewallActionDrop, UDP, ports, addresses)
+}
+
+// DenyICMP drops ICMP traffic, see AllowTCP
+func (b *FirewallRuleBuilder) DenyICMP(addresses ...string) *FirewallRuleBuilder {
+	return b.addRule(firewallActionDrop, ICMP, "", addresses)
+}
+
+// AllowAll accepts the traffic that does not match any rule
+func (b *FirewallRuleBuilder) AllowAll() *FirewallRuleBuilder {
+	return b.setPolicy(firewallActionAccept)
+}
+
+// DenyAll drops the traffic that does not match any rule
+func (b *FirewallRuleBuilder) DenyAll() *FirewallRuleBuilder {
+	return b.setPolicy(firewallActionDrop)
+}
+
+// WithLabel sets the label of the last added rule, replacing the generated label
+func (b *FirewallRuleBuilder) WithLabel(label string) *FirewallRuleBuilder {
+	rules := b.currentRules()
+	if len(*rules) == 0 {
+		b.errs = append(b.errs, errors.New("no rule to label"))
+		return b
+	}
+
+	if len(label) < 3 || len(label) > firewallRuleMaxLabelLength {
+		b.errs = append(b.errs, fmt.Errorf("label %q must be between 3 and %d characters", label, firewallRuleMaxLabelLength))
+		return b
+	}
+
+	(*rules)[len(*rules)-1].Label = label
+
+	return b
+}
+
+// WithDescription sets the description of the last added rule
+func (b *FirewallRuleBuilder) WithDescription(description string) *FirewallRuleBuilder {
+	rules := b.currentRules()
+	if len(*rules) == 0 {
+		b.errs = append(b.errs, errors.New("no rule to describe"))
+		return b
+	}
+
+	(*rules)[len(*rules)-1].Description = description
+
+	return b
+}
+
+// Build returns the FirewallRuleSet, or the errors encountered while building it
+func (b *FirewallRuleBuilder) Build() (FirewallRuleSet, error) {
+	if len(b.errs) > 0 {
+		return FirewallRuleSet{}, errors.Join(b.errs...)
+	}
+
+	rules := b.rules
+	rules.Inbound = append([]FirewallRule{}, b.rules.Inbound...)
+	rules.Outbound = append([]FirewallRule{}, b.rules.Outbound...)
+
+	return rules, nil
+}
+
+func (b *FirewallRuleBuilder) currentRules() *[]FirewallRule {
+	if b.outbound {
+		return &b.rules.Outbound
+	}
+
+	return &b.rules.Inbound
+}
+
+func (b *FirewallRuleBuilder) setPolicy(policy string) *FirewallRuleBuilder {
+	if b.outbound {
+		b.rules.OutboundPolicy = policy
+	} else {
+		b.rules.InboundPolicy = policy
+	}
+
+	return b
+}
+
+func (b *FirewallRuleBuilder) addRule(action string, protocol NetworkProtocol, ports string, addresses []string) *FirewallRuleBuilder {
+	if protocol != ICMP {
+		if err := validateFirewallPorts(ports); err != nil {
+			b.errs = append(b.errs, err)
+			return b
+		}
+	}
+
+	networkAddresses, err := firewallNetworkAddresses(addresses)
+	if err != nil {
+		b.errs = append(b.errs, err)
+		return b
+	}
+
+	direction := "inbound"
+	if b.outbound {
+		direction = "outbound"
+	}
+
+	label := strings.ToLower(fmt.Sprintf("%s-%s-%s", action, direction, protocol))
+	if ports != "" {
+		label += "-" + strings.NewReplacer(",", "_", " ", "").Replace(ports)
+	}
+
+	if len(label) > firewallRuleMaxLabelLength {
+		label = label[:firewallRuleMaxLabelLength]
+	}
+
+	rules := b.currentRules()
+	*rules = append(*rules, FirewallRule{
+		Action:    action,
+		Label:     label,
+		Ports:     ports,
+		Protocol:  protocol,
+		Addresses: networkAddresses,
+	})
+
+	return b
+}
+
+// validateFirewallPorts checks that ports is a comma separated list of ports and port ranges
+func validateFirewallPorts(ports string) error {
+	if ports == "" {
+		return errors.New("ports must be specified for TCP and UDP rules")
+	}
+
+	segments := strings.Split(ports, ",")
+	if len(segments) > firewallRuleMaxPorts {
+		return fmt.Errorf("ports %q must contain at most %d ports and port ranges", ports, firewallRuleMaxPorts)
+	}
+
+	for _, segment := range segments {
+		start, end, isRange := strings.Cut(strings.TrimSpace(segment), "-")
+
+		first, err := parseFirewallPort(start)
+		if err != nil {
+			return fmt.Errorf("invalid ports %q: %w", ports, err)
+		}
+
+		if !isRange {
+			continue
+		}
+
+		last, err := parseFirewallPort(end)
+		if err != nil {
+			return fmt.Errorf("invalid ports %q: %w", ports, err)
+		}
+
+		if first >= last {
+			return fmt.Errorf("invalid ports %q: range %s must be ascending", ports, segment)
+		}
+	}
+
+	return nil
+}
+
+func parseFirewallPort(port string) (int, error) {
+	p, err := strconv.Atoi(port)
+	if err != nil || p < 1 || p > 65535 {
+		return 0, fmt.Errorf("port %q must be a number between 1 and 65535", port)
+	}
+
+	return p, nil
+}
+
+// firewallNetworkAddresses splits addresses into IPv4 and IPv6 addresses,
+// defaulting to all addresses if none are given.
+func firewallNetworkAddresses(addresses []string) (NetworkAddresses, error) {
+	if len(addresses) == 0 {
+		return NetworkAddresses{
+			IPv4: &[]string{"0.0.0.0/0"},
+			IPv6: &[]string{"::/0"},
+		}, nil
+	}
+
+	var ipv4, ipv6 []string
+
+	for _, address := range addresses {
+		ip := parseACLAddress(address)
+
+		switch {
+		case ip == nil:
+			return NetworkAddresses{}, fmt.Errorf("invalid address %q, must be an IP address or CIDR", address)
+		case ip.To4() != nil:
+			ipv4 = append(ipv4, address)
+		default:
+			ipv6 = append(ipv6, address)
+		}
+	}
+
+	var result NetworkAddresses
+
+	if ipv4 != nil {
+		result.IPv4 = &ipv4
+	}
+
+	if ipv6 != nil {
+		result.IPv6 = &ipv6
+	}
+
+	return result, nil
+}
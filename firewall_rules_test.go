@@ -0,0 +1,188 @@
+package linodego
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestClient_UpdateFirewallRulesIfUnchanged(t *testing.T) {
+	version := 3
+	updates := 0
+
+	ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v4/networking/firewalls/123/rules" {
+			rw.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		if r.Method == http.MethodPut {
+			body, _ := io.ReadAll(r.Body)
+			if strings.Contains(string(body), "version") {
+				t.Errorf("expected the read-only version not to be sent: %s", body)
+			}
+
+			updates++
+			version++
+		}
+
+		rw.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(rw).Encode(map[string]any{
+			"inbound_policy": "DROP", "outbound_policy": "ACCEPT", "version": version, "fingerprint": "abc",
+		})
+	}))
+	defer ts.Close()
+
+	client := NewClient(nil)
+	client.SetBaseURL(ts.URL)
+
+	rules, err := client.GetFirewallRules(context.Background(), 123)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if rules.Version != 3 || rules.Fingerprint != "abc" {
+		t.Fatalf("unexpected rules version %d and fingerprint %q", rules.Version, rules.Fingerprint)
+	}
+
+	updated, err := client.UpdateFirewallRulesIfUnchanged(context.Background(), 123, *rules, rules.Version)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if updated.Version != 4 {
+		t.Errorf("expected the rules to be updated to version 4, got %d", updated.Version)
+	}
+
+	// The rules were changed since they were fetched
+	_, err = client.UpdateFirewallRulesIfUnchanged(context.Background(), 123, *rules, rules.Version)
+	if !errors.Is(err, ErrConflict) {
+		t.Fatalf("expected a conflict error, got %v", err)
+	}
+
+	if updates != 1 {
+		t.Errorf("expected the conflicting rules not to be sent, got %d updates", updates)
+	}
+}
+
+func TestFirewallRuleBuilder(t *testing.T) {
+	rules, err := NewFirewallRuleBuilder().
+		AllowTCP("22", "192.0.2.0/24", "2001:db8::/32").WithLabel("ssh").
+		AllowTCP("80,443").
+		AllowICMP().
+		Outbound().
+		DenyUDP("1000-2000", "198.51.100.1").WithDescription("no udp").
+		DenyAll().
+		Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if rules.InboundPolicy != "DROP" || rules.OutboundPolicy != "DROP" {
+		t.Errorf("unexpected policies %s and %s", rules.InboundPolicy, rules.OutboundPolicy)
+	}
+
+	if len(rules.Inbound) != 3 || len(rules.Outbound) != 1 {
+		t.Fatalf("expected 3 inbound and 1 outbound rules, got %d and %d", len(rules.Inbound), len(rules.Outbound))
+	}
+
+	ssh := rules.Inbound[0]
+	if ssh.Label != "ssh" || ssh.Action != "ACCEPT" || ssh.Protocol != TCP || ssh.Ports != "22" {
+		t.Errorf("unexpected SSH rule %#v", ssh)
+	}
+
+	if (*ssh.Addresses.IPv4)[0] != "192.0.2.0/24" || (*ssh.Addresses.IPv6)[0] != "2001:db8::/32" {
+		t.Errorf("unexpected SSH rule addresses %v, %v", *ssh.Addresses.IPv4, *ssh.Addresses.IPv6)
+	}
+
+	web := rules.Inbound[1]
+	if web.Label != "accept-inbound-tcp-80_443" || (*web.Addresses.IPv4)[0] != "0.0.0.0/0" || (*web.Addresses.IPv6)[0] != "::/0" {
+		t.Errorf("unexpected web rule %#v", web)
+	}
+
+	udp := rules.Outbound[0]
+	if udp.Action != "DROP" || udp.Description != "no udp" || udp.Addresses.IPv6 != nil {
+		t.Errorf("unexpected UDP rule %#v", udp)
+	}
+}
+
+func TestFirewallRuleBuilder_Invalid(t *testing.T) {
+	tests := map[string]*FirewallRuleBuilder{
+		"missing ports":     NewFirewallRuleBuilder().AllowTCP(""),
+		"port out of range": NewFirewallRuleBuilder().AllowTCP("65536"),
+		"descending range":  NewFirewallRuleBuilder().AllowUDP("443-80"),
+		"invalid port":      NewFirewallRuleBuilder().AllowTCP("ssh"),
+		"too many ports":    NewFirewallRuleBuilder().AllowTCP("1,2,3,4,5,6,7,8,9,10,11,12,13,14,15,16"),
+		"invalid address":   NewFirewallRuleBuilder().AllowTCP("22", "10.0.0.0/33"),
+		"unlabelled rule":   NewFirewallRuleBuilder().WithLabel("ssh"),
+		"short label":       NewFirewallRuleBuilder().AllowTCP("22").WithLabel("x"),
+	}
+
+	for name, builder := range tests {
+		if _, err := builder.Build(); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
+
+func TestClient_UpsertFirewallRuleByLabel(t *testing.T) {
+	var sent FirewallRuleSet
+
+	ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Set("Content-Type", "application/json")
+
+		if r.Method == http.MethodPut {
+			if err := json.NewDecoder(r.Body).Decode(&sent); err != nil {
+				t.Error(err)
+			}
+
+			json.NewEncoder(rw).Encode(sent)
+
+			return
+		}
+
+		rw.Write([]byte(`{"inbound_policy": "DROP", "outbound_policy": "ACCEPT", "version": 1, "inbound": [
+			{"action": "ACCEPT", "label": "ssh", "protocol": "TCP", "ports": "22"},
+			{"action": "ACCEPT", "label": "web", "protocol": "TCP", "ports": "80"},
+			{"action": "ACCEPT", "label": "dup", "protocol": "TCP", "ports": "81"},
+			{"action": "ACCEPT", "label": "dup", "protocol": "TCP", "ports": "82"}
+		], "outbound": []}`))
+	}))
+	defer ts.Close()
+
+	client := NewClient(nil)
+	client.SetBaseURL(ts.URL)
+
+	rules, err := client.UpsertFirewallRuleByLabel(context.Background(), 123, FirewallRuleInbound,
+		FirewallRule{Action: "ACCEPT", Label: "web", Protocol: TCP, Ports: "80,443"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(rules.Inbound) != 4 || rules.Inbound[1].Ports != "80,443" || rules.Inbound[0].Ports != "22" {
+		t.Errorf("expected the web rule to be replaced, got %+v", rules)
+	}
+
+	rules, err = client.UpsertFirewallRuleByLabel(context.Background(), 123, FirewallRuleOutbound,
+		FirewallRule{Action: "DROP", Label: "smtp", Protocol: TCP, Ports: "25"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(rules.Outbound) != 1 || len(rules.Inbound) != 4 || rules.Outbound[0].Label != "smtp" {
+		t.Errorf("expected the smtp rule to be appended, got %+v", rules)
+	}
+
+	if _, err := client.UpsertFirewallRuleByLabel(context.Background(), 123, FirewallRuleInbound, FirewallRule{Label: "dup"}); err == nil {
+		t.Error("expected an error for an ambiguous label")
+	}
+
+	if _, err := client.UpsertFirewallRuleByLabel(context.Background(), 123, FirewallRuleInbound, FirewallRule{}); err == nil {
+		t.Error("expected an error for a missing label")
+	}
+}
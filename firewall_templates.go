@@ -0,0 +1,59 @@
+package linodego
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// FirewallTemplate is a predefined set of Firewall rules, e.g. the "public" or "vpc" baseline rules.
+type FirewallTemplate struct {
+	Slug  string          `json:"slug"`
+	Rules FirewallRuleSet `json:"rules"`
+}
+
+// FirewallTemplatesPagedResponse represents a Linode API response for listing of Firewall Templates
+type FirewallTemplatesPagedResponse struct {
+	*PageOptions
+	Data []FirewallTemplate `json:"data"`
+}
+
+func (FirewallTemplatesPagedResponse) endpoint(_ ...any) string {
+	return "networking/firewalls/templates"
+}
+
+func (resp *FirewallTemplatesPagedResponse) castResult(r *resty.Request, e string) (int, int, error) {
+	res, err := coupleAPIErrors(r.SetResult(FirewallTemplatesPagedResponse{}).Get(e))
+	if err != nil {
+		return 0, 0, err
+	}
+	castedRes := res.Result().(*FirewallTemplatesPagedResponse)
+	resp.Data = append(resp.Data, castedRes.Data...)
+	return castedRes.Pages, castedRes.Results, nil
+}
+
+// ListFirewallTemplates returns a paginated list of Firewall Templates
+func (c *Client) ListFirewallTemplates(ctx context.Context, opts *ListOptions) ([]FirewallTemplate, error) {
+	response := FirewallTemplatesPagedResponse{}
+
+	err := c.listHelper(ctx, &response, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
+
+// GetFirewallTemplate gets the Firewall Template with the provided slug
+func (c *Client) GetFirewallTemplate(ctx context.Context, slug string) (*FirewallTemplate, error) {
+	e := fmt.Sprintf("networking/firewalls/templates/%s", url.PathEscape(slug))
+	req := c.R(ctx).SetResult(&FirewallTemplate{})
+	r, err := coupleAPIErrors(req.Get(e))
+	if err != nil {
+		return nil, err
+	}
+
+	return r.Result().(*FirewallTemplate), nil
+}
@@ -0,0 +1,107 @@
+package linodego
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestClient_FirewallTemplates(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/v4/networking/firewalls/templates":
+			rw.Write([]byte(`{"data": [{"slug": "public", "rules": {"inbound_policy": "DROP", "outbound_policy": "ACCEPT"}}, {"slug": "vpc"}], "page": 1, "pages": 1, "results": 2}`))
+		case "/v4/networking/firewalls/templates/vpc":
+			rw.Write([]byte(`{"slug": "vpc", "rules": {"inbound": [{"action": "ACCEPT", "protocol": "TCP", "ports": "22"}], "inbound_policy": "DROP"}}`))
+		default:
+			rw.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	client := NewClient(nil)
+	client.SetBaseURL(ts.URL)
+
+	templates, err := client.ListFirewallTemplates(context.Background(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(templates) != 2 || templates[0].Slug != "public" || templates[0].Rules.InboundPolicy != "DROP" {
+		t.Errorf("unexpected templates %+v", templates)
+	}
+
+	template, err := client.GetFirewallTemplate(context.Background(), "vpc")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(template.Rules.Inbound) != 1 || template.Rules.Inbound[0].Ports != "22" {
+		t.Errorf("unexpected template %+v", template)
+	}
+}
+
+func TestClient_UpdateAccountSettingsDefaultFirewalls(t *testing.T) {
+	updated := false
+
+	var body string
+
+	ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Set("Content-Type", "application/json")
+
+		switch r.Method + " " + r.URL.Path {
+		case "GET /v4/networking/firewalls/123":
+			rw.Write([]byte(`{"id": 123}`))
+		case "PUT /v4/account/settings":
+			updated = true
+
+			b, _ := io.ReadAll(r.Body)
+			body = string(b)
+
+			rw.Write([]byte(`{"default_firewall_ids": {"linode": 123, "public_interface": 123, "vpc_interface": null, "nodebalancer": null}}`))
+		default:
+			rw.WriteHeader(http.StatusNotFound)
+			rw.Write([]byte(`{"errors": [{"reason": "Not found"}]}`))
+		}
+	}))
+	defer ts.Close()
+
+	client := NewClient(nil)
+	client.SetBaseURL(ts.URL)
+
+	firewallID, missingID := 123, 456
+
+	settings, err := client.UpdateAccountSettings(context.Background(), AccountSettingsUpdateOptions{
+		DefaultFirewallIDs: &DefaultFirewallIDsUpdateOptions{
+			Linode:          NewNullableInt(&firewallID),
+			PublicInterface: NewNullableInt(&firewallID),
+			VPCInterface:    NewNullableInt(nil),
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The NodeBalancer default is not set, so it must be left unchanged
+	if expected := `{"default_firewall_ids":{"linode":123,"public_interface":123,"vpc_interface":null}}`; body != expected {
+		t.Errorf("expected body %s, got %s", expected, body)
+	}
+
+	if settings.DefaultFirewallIDs == nil || *settings.DefaultFirewallIDs.Linode != 123 || settings.DefaultFirewallIDs.VPCInterface != nil {
+		t.Errorf("unexpected settings %+v", settings)
+	}
+
+	updated = false
+
+	_, err = client.UpdateAccountSettings(context.Background(), AccountSettingsUpdateOptions{
+		DefaultFirewallIDs: &DefaultFirewallIDsUpdateOptions{Linode: NewNullableInt(&firewallID), NodeBalancer: NewNullableInt(&missingID)},
+	})
+	if !errors.Is(err, ErrNotFound) || updated {
+		t.Errorf("expected the settings not to be updated with a missing firewall, got %v", err)
+	}
+}
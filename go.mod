@@ -4,11 +4,18 @@ require (
 	github.com/go-resty/resty/v2 v2.11.0
 	github.com/google/go-cmp v0.6.0
 	golang.org/x/net v0.19.0
+	golang.org/x/oauth2 v0.8.0
 	golang.org/x/text v0.14.0
 	gopkg.in/ini.v1 v1.66.6
+	gopkg.in/yaml.v3 v3.0.1
 )
 
-require github.com/stretchr/testify v1.8.4 // indirect
+require (
+	github.com/golang/protobuf v1.5.2 // indirect
+	github.com/stretchr/testify v1.8.4 // indirect
+	google.golang.org/appengine v1.6.7 // indirect
+	google.golang.org/protobuf v1.28.0 // indirect
+)
 
 go 1.20
 
@@ -0,0 +1,55 @@
+package linodego
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+)
+
+const (
+	// idempotencyTagPrefix prefixes the tag recording the idempotency key of a resource
+	idempotencyTagPrefix = "idempotency:"
+
+	// maxTagLength is the maximum length of a tag accepted by the API
+	maxTagLength = 50
+)
+
+// IdempotencyTag returns the tag recording an idempotency key on the resources created with it.
+// Keys too long for a tag are recorded by their hash.
+func IdempotencyTag(key string) string {
+	if len(idempotencyTagPrefix)+len(key) <= maxTagLength {
+		return idempotencyTagPrefix + key
+	}
+
+	sum := sha256.Sum256([]byte(key))
+
+	return idempotencyTagPrefix + hex.EncodeToString(sum[:])[:maxTagLength-len(idempotencyTagPrefix)]
+}
+
+// CreateInstanceWithIdempotency creates a Linode instance unless one was already created with the same key,
+// so that repeating a create, e.g. after a crash, does not create a duplicate instance.
+//
+// The API does not support idempotency keys, so the key is recorded as a tag of the instance, see IdempotencyTag,
+// which is looked up before creating. If an instance with the tag exists, it is returned with ErrAlreadyExists.
+// Creates with the same key that run concurrently are not deduplicated.
+func (c *Client) CreateInstanceWithIdempotency(ctx context.Context, opts InstanceCreateOptions, key string) (*Instance, error) {
+	if key == "" {
+		return nil, errors.New("an idempotency key is required")
+	}
+
+	tag := IdempotencyTag(key)
+
+	instances, err := c.ListInstances(ctx, NewListOptions(1, NewFilter().Eq("tags", tag).String()))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(instances) > 0 {
+		return &instances[0], ErrAlreadyExists
+	}
+
+	opts.Tags = append(append([]string{}, opts.Tags...), tag)
+
+	return c.CreateInstance(ctx, opts)
+}
@@ -0,0 +1,69 @@
+package linodego
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestIdempotencyTag(t *testing.T) {
+	if tag := IdempotencyTag("reconcile-123"); tag != "idempotency:reconcile-123" {
+		t.Errorf("unexpected tag %q", tag)
+	}
+
+	long := strings.Repeat("k", 100)
+	if tag := IdempotencyTag(long); len(tag) != maxTagLength || tag != IdempotencyTag(long) || tag == IdempotencyTag(long+"2") {
+		t.Errorf("unexpected tag %q for a long key", tag)
+	}
+}
+
+func TestClient_CreateInstanceWithIdempotency(t *testing.T) {
+	var created []Instance
+
+	ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Set("Content-Type", "application/json")
+
+		switch r.Method {
+		case http.MethodGet:
+			if r.Header.Get("X-Filter") != `{"tags":"idempotency:reconcile-123"}` {
+				t.Errorf("unexpected filter %s", r.Header.Get("X-Filter"))
+			}
+
+			json.NewEncoder(rw).Encode(map[string]any{"data": created, "page": 1, "pages": 1, "results": len(created)})
+		case http.MethodPost:
+			var opts InstanceCreateOptions
+			if err := json.NewDecoder(r.Body).Decode(&opts); err != nil {
+				t.Fatal(err)
+			}
+
+			instance := Instance{ID: 123, Label: opts.Label, Tags: opts.Tags}
+			created = append(created, instance)
+
+			json.NewEncoder(rw).Encode(instance)
+		}
+	}))
+	defer ts.Close()
+
+	client := NewClient(nil)
+	client.SetBaseURL(ts.URL)
+
+	opts := InstanceCreateOptions{Region: "us-east", Type: "g6-nanode-1", Label: "web", Tags: []string{"prod"}}
+
+	instance, err := client.CreateInstanceWithIdempotency(context.Background(), opts, "reconcile-123")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(instance.Tags) != 2 || instance.Tags[1] != "idempotency:reconcile-123" || len(opts.Tags) != 1 {
+		t.Errorf("unexpected tags %v", instance.Tags)
+	}
+
+	instance, err = client.CreateInstanceWithIdempotency(context.Background(), opts, "reconcile-123")
+	if !errors.Is(err, ErrAlreadyExists) || instance == nil || instance.ID != 123 || len(created) != 1 {
+		t.Errorf("expected the existing instance, got %v and %v", instance, err)
+	}
+}
@@ -1,15 +1,21 @@
 package linodego
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"net/http"
 	"net/url"
+	"os"
+	"strings"
 	"time"
 
 	"github.com/go-resty/resty/v2"
 	"github.com/linode/linodego/internal/parseabletime"
+	"golang.org/x/oauth2"
 )
 
 // ImageStatus represents the status of an Image.
@@ -22,6 +28,26 @@ const (
 	ImageStatusAvailable     ImageStatus = "available"
 )
 
+// ImageRegionStatus represents the replication status of an Image in a region.
+type ImageRegionStatus string
+
+// ImageRegionStatus options start with ImageRegionStatus and include all Image region statuses
+const (
+	ImageRegionStatusAvailable          ImageRegionStatus = "available"
+	ImageRegionStatusCreating           ImageRegionStatus = "creating"
+	ImageRegionStatusPending            ImageRegionStatus = "pending"
+	ImageRegionStatusPendingReplication ImageRegionStatus = "pending replication"
+	ImageRegionStatusPendingDeletion    ImageRegionStatus = "pending deletion"
+	ImageRegionStatusReplicating        ImageRegionStatus = "replicating"
+	ImageRegionStatusTimedOut           ImageRegionStatus = "timedout"
+)
+
+// ImageRegion represents the replication of an Image to a region
+type ImageRegion struct {
+	Region string            `json:"region"`
+	Status ImageRegionStatus `json:"status"`
+}
+
 // Image represents a deployable Image object for use with Linode Instances
 type Image struct {
 	ID           string      `json:"id"`
@@ -37,6 +63,14 @@ type Image struct {
 	Deprecated   bool        `json:"deprecated"`
 	Created      *time.Time  `json:"-"`
 	Expiry       *time.Time  `json:"-"`
+
+	// Regions are the regions the Image is replicated to and their replication status
+	Regions []ImageRegion `json:"regions"`
+}
+
+// ImageReplicateOptions fields are those accepted by ReplicateImage
+type ImageReplicateOptions struct {
+	Regions []string `json:"regions"`
 }
 
 // ImageCreateOptions fields are those accepted by CreateImage
@@ -150,7 +184,7 @@ func (c *Client) GetImage(ctx context.Context, imageID string) (*Image, error) {
 
 // CreateImage creates an Image
 func (c *Client) CreateImage(ctx context.Context, opts ImageCreateOptions) (*Image, error) {
-	body, err := json.Marshal(opts)
+	body, err := c.marshalJSON(opts)
 	if err != nil {
 		return nil, err
 	}
@@ -166,7 +200,7 @@ func (c *Client) CreateImage(ctx context.Context, opts ImageCreateOptions) (*Ima
 
 // UpdateImage updates the Image with the specified id
 func (c *Client) UpdateImage(ctx context.Context, imageID string, opts ImageUpdateOptions) (*Image, error) {
-	body, err := json.Marshal(opts)
+	body, err := c.marshalJSON(opts)
 	if err != nil {
 		return nil, err
 	}
@@ -190,9 +224,64 @@ func (c *Client) DeleteImage(ctx context.Context, imageID string) error {
 	return err
 }
 
+// ReplicateImage replicates the Image to the provided regions. The regions are the complete set of
+// regions of the Image: the Image is removed from any region it is replicated to that is not provided.
+// The regions are checked against ListRegions before the request is sent.
+// Use WaitForImageRegionsAvailable to wait for the replication to complete.
+func (c *Client) ReplicateImage(ctx context.Context, imageID string, regions []string) (*Image, error) {
+	if len(regions) == 0 {
+		return nil, errors.New("at least one region is required to replicate an image")
+	}
+
+	if err := c.validateRegionIDs(ctx, regions); err != nil {
+		return nil, err
+	}
+
+	body, err := c.marshalJSON(ImageReplicateOptions{Regions: regions})
+	if err != nil {
+		return nil, err
+	}
+
+	e := fmt.Sprintf("images/%s/regions", url.PathEscape(imageID))
+	req := c.R(ctx).SetResult(&Image{}).SetBody(string(body))
+	r, err := coupleAPIErrors(req.Post(e))
+	if err != nil {
+		return nil, err
+	}
+
+	return r.Result().(*Image), nil
+}
+
+// validateRegionIDs checks that all of the region IDs are returned by ListRegions
+func (c *Client) validateRegionIDs(ctx context.Context, regionIDs []string) error {
+	regions, err := c.ListRegions(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	known := make(map[string]bool, len(regions))
+	for _, region := range regions {
+		known[region.ID] = true
+	}
+
+	var unknown []string
+
+	for _, regionID := range regionIDs {
+		if !known[regionID] {
+			unknown = append(unknown, regionID)
+		}
+	}
+
+	if len(unknown) > 0 {
+		return fmt.Errorf("unknown regions: %s", strings.Join(unknown, ", "))
+	}
+
+	return nil
+}
+
 // CreateImageUpload creates an Image and an upload URL
 func (c *Client) CreateImageUpload(ctx context.Context, opts ImageCreateUploadOptions) (*Image, string, error) {
-	body, err := json.Marshal(opts)
+	body, err := c.marshalJSON(opts)
 	if err != nil {
 		return nil, "", err
 	}
@@ -212,22 +301,106 @@ func (c *Client) CreateImageUpload(ctx context.Context, opts ImageCreateUploadOp
 	return result.Image, result.UploadTo, nil
 }
 
-// UploadImageToURL uploads the given image to the given upload URL
+// UploadImageToURL uploads the given gzip compressed image to the given upload URL.
+// The image is streamed without being buffered when its size is known, i.e. for
+// *os.File, *bytes.Reader, *bytes.Buffer and *strings.Reader. The upload is sent through
+// the transport of the Client, e.g. one set with SetTransport or SetProxy, without its token.
 func (c *Client) UploadImageToURL(ctx context.Context, uploadURL string, image io.Reader) error {
-	// Linode-specific headers do not need to be sent to this endpoint
-	req := resty.New().SetDebug(c.resty.Debug).R().
-		SetContext(ctx).
-		SetContentLength(true).
-		SetHeader("Content-Type", "application/octet-stream").
-		SetBody(image)
+	size, sized := imageUploadSize(image)
+	if !sized {
+		// The upload URL requires a Content-Length
+		data, err := io.ReadAll(image)
+		if err != nil {
+			return fmt.Errorf("failed to read image: %w", err)
+		}
+
+		image, size = bytes.NewReader(data), int64(len(data))
+	}
 
-	_, err := coupleAPIErrors(req.
-		Put(uploadURL))
+	// Images must be gzip compressed, which is otherwise only reported once the upload is processed
+	header := make([]byte, len(gzipMagic))
+	if _, err := io.ReadFull(image, header); err != nil || !bytes.Equal(header, gzipMagic) {
+		return errors.New("failed to upload image: image is not gzip compressed")
+	}
 
-	return err
+	// Linode-specific headers and authentication must not be sent to this endpoint
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, uploadURL, io.MultiReader(bytes.NewReader(header), image))
+	if err != nil {
+		return err
+	}
+
+	req.ContentLength = size
+	req.Header.Set("Content-Type", "application/octet-stream")
+
+	if c.debug {
+		c.logger.Debugf("Uploading %d byte image to %s://%s%s", size, req.URL.Scheme, req.URL.Host, req.URL.Path)
+	}
+
+	resp, err := c.uploadHTTPClient().Do(req)
+	if err != nil {
+		return NewError(err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusMultipleChoices {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+
+		return &Error{
+			Code:     resp.StatusCode,
+			Message:  fmt.Sprintf("failed to upload image: %s", strings.TrimSpace(string(body))),
+			Response: resp,
+		}
+	}
+
+	return nil
 }
 
-// UploadImage creates and uploads an image
+// uploadHTTPClient returns an http.Client using the transport of the Client without the
+// OAuth2 transport of an http.Client passed to NewClient, as upload URLs must not receive the token.
+// The dry run transport is also left out, as it would log the image instead of the request.
+func (c *Client) uploadHTTPClient() *http.Client {
+	transport := c.resty.GetClient().Transport
+
+	if t, ok := transport.(*dryRunTransport); ok {
+		transport = t.next
+	}
+
+	if t, ok := transport.(*oauth2.Transport); ok {
+		transport = t.Base
+	}
+
+	return &http.Client{Transport: transport}
+}
+
+// gzipMagic are the first bytes of gzip compressed data
+var gzipMagic = []byte{0x1f, 0x8b}
+
+// imageUploadSize returns the number of bytes left to read from image, if it can be determined without reading it
+func imageUploadSize(image io.Reader) (int64, bool) {
+	switch r := image.(type) {
+	case interface{ Len() int }:
+		return int64(r.Len()), true
+	case *os.File:
+		info, err := r.Stat()
+		if err != nil || !info.Mode().IsRegular() {
+			return 0, false
+		}
+
+		offset, err := r.Seek(0, io.SeekCurrent)
+		if err != nil {
+			return 0, false
+		}
+
+		return info.Size() - offset, true
+	}
+
+	return 0, false
+}
+
+// UploadImage creates an image and uploads opts.Image to it, see UploadImageToURL.
+// If the upload fails, the created image is returned along with the error; it remains
+// pending upload until it is deleted. Use WaitForImageStatus to wait for the uploaded
+// image to become available.
 func (c *Client) UploadImage(ctx context.Context, opts ImageUploadOptions) (*Image, error) {
 	image, uploadURL, err := c.CreateImageUpload(ctx, ImageCreateUploadOptions{
 		Label:       opts.Label,
@@ -241,3 +414,26 @@ func (c *Client) UploadImage(ctx context.Context, opts ImageUploadOptions) (*Ima
 
 	return image, c.UploadImageToURL(ctx, uploadURL, opts.Image)
 }
+
+// CaptureInstanceImage creates a private Image from the Instance disk with the provided ID and
+// waits for it to become available, see WaitForImageStatus. The Image is created with the
+// creating status, so it can not be mistaken for available before the capture has started.
+// If the Image does not become available within timeoutSeconds, the created Image is returned
+// along with the error so that the caller can decide whether to delete it.
+func (c *Client) CaptureInstanceImage(ctx context.Context, diskID int, label, description string, timeoutSeconds int) (*Image, error) {
+	image, err := c.CreateImage(ctx, ImageCreateOptions{
+		DiskID:      diskID,
+		Label:       label,
+		Description: description,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	available, err := c.WaitForImageStatus(ctx, image.ID, ImageStatusAvailable, timeoutSeconds)
+	if err != nil {
+		return image, fmt.Errorf("failed to capture Image %s from disk %d: %w", image.ID, diskID, err)
+	}
+
+	return available, nil
+}
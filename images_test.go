@@ -0,0 +1,253 @@
+package linodego
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"golang.org/x/oauth2"
+)
+
+// testGzipImage is a minimal gzip compressed image
+var testGzipImage = []byte{
+	0x1f, 0x8b, 0x08, 0x08, 0xbd, 0x5c, 0x91, 0x60,
+	0x00, 0x03, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x69, 0x6d, 0x67, 0x00, 0x03, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+}
+
+func TestClient_UploadImage(t *testing.T) {
+	var uploaded []byte
+
+	var ts *httptest.Server
+	ts = httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v4/images/upload":
+			rw.Header().Set("Content-Type", "application/json")
+			rw.Write([]byte(`{"image": {"id": "private/123", "status": "pending_upload"}, "upload_to": "` + ts.URL + `/upload?signature=secret"}`))
+		case "/upload":
+			if r.Method != http.MethodPut || r.ContentLength != int64(len(testGzipImage)) || r.Header.Get("Authorization") != "" {
+				t.Errorf("unexpected upload %s with length %d", r.Method, r.ContentLength)
+			}
+
+			uploaded, _ = io.ReadAll(r.Body)
+		default:
+			rw.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	client := NewClient(nil)
+	client.SetBaseURL(ts.URL)
+	client.SetToken("token")
+
+	path := filepath.Join(t.TempDir(), "image.img.gz")
+	if err := os.WriteFile(path, testGzipImage, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+
+	image, err := client.UploadImage(context.Background(), ImageUploadOptions{Region: "us-east", Label: "test", Image: file})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if image.ID != "private/123" || !bytes.Equal(uploaded, testGzipImage) {
+		t.Errorf("unexpected image %s with upload %v", image.ID, uploaded)
+	}
+
+	// Readers of unknown size are buffered to determine their length
+	uploaded = nil
+	if err := client.UploadImageToURL(context.Background(), ts.URL+"/upload", io.MultiReader(bytes.NewReader(testGzipImage))); err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(uploaded, testGzipImage) {
+		t.Errorf("unexpected upload %v", uploaded)
+	}
+}
+
+func TestClient_UploadImageToURL_transport(t *testing.T) {
+	var uploads []*http.Request
+
+	transport := roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		uploads = append(uploads, r)
+
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewReader(nil)), Request: r}, nil
+	})
+
+	// The token of an OAuth2 client must not be sent to the upload URL
+	client := NewClient(&http.Client{Transport: &oauth2.Transport{
+		Source: oauth2.StaticTokenSource(&oauth2.Token{AccessToken: "token"}),
+		Base:   transport,
+	}})
+
+	if err := client.UploadImageToURL(context.Background(), "https://upload.example/image", bytes.NewReader(testGzipImage)); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(uploads) != 1 || uploads[0].URL.Host != "upload.example" || uploads[0].Header.Get("Authorization") != "" {
+		t.Fatalf("expected the image to be uploaded through the transport without a token, got %v", uploads)
+	}
+
+	uploads = nil
+
+	client = NewClient(nil)
+	client.SetTransport(transport)
+
+	if err := client.UploadImageToURL(context.Background(), "https://upload.example/image", bytes.NewReader(testGzipImage)); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(uploads) != 1 {
+		t.Errorf("expected the image to be uploaded through the transport set with SetTransport, got %d uploads", len(uploads))
+	}
+}
+
+func TestClient_UploadImageToURL_errors(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Set("Content-Type", "application/xml")
+		rw.WriteHeader(http.StatusForbidden)
+		rw.Write([]byte(`<Error><Code>SignatureDoesNotMatch</Code></Error>`))
+	}))
+	defer ts.Close()
+
+	client := NewClient(nil)
+
+	if err := client.UploadImageToURL(context.Background(), ts.URL, bytes.NewReader([]byte("not gzip"))); err == nil {
+		t.Error("expected an error for an image that is not gzip compressed")
+	}
+
+	err := client.UploadImageToURL(context.Background(), ts.URL, bytes.NewReader(testGzipImage))
+	if e, ok := err.(*Error); !ok || e.Code != http.StatusForbidden {
+		t.Errorf("expected a forbidden error, got %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if err := client.UploadImageToURL(ctx, ts.URL, bytes.NewReader(testGzipImage)); err == nil {
+		t.Error("expected an error for a canceled context")
+	}
+}
+
+func TestClient_ReplicateImage(t *testing.T) {
+	imageRequests := 0
+
+	ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/v4/regions":
+			rw.Write([]byte(`{"data": [{"id": "us-east"}, {"id": "us-west"}, {"id": "eu-west"}], "page": 1, "pages": 1, "results": 3}`))
+		case "/v4/images/private/123/regions":
+			body, _ := io.ReadAll(r.Body)
+			if string(body) != `{"regions":["us-east","eu-west"]}` {
+				t.Errorf("unexpected body %s", body)
+			}
+
+			rw.Write([]byte(`{"id": "private/123", "regions": [
+				{"region": "us-east", "status": "available"}, {"region": "eu-west", "status": "pending replication"}]}`))
+		case "/v4/images/private/123":
+			imageRequests++
+
+			status := "replicating"
+			if imageRequests > 1 {
+				status = "available"
+			}
+
+			rw.Write([]byte(`{"id": "private/123", "regions": [
+				{"region": "us-east", "status": "available"}, {"region": "eu-west", "status": "` + status + `"}]}`))
+		default:
+			rw.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	client := NewClient(nil)
+	client.SetBaseURL(ts.URL)
+	client.SetPollDelay(time.Millisecond)
+
+	if _, err := client.ReplicateImage(context.Background(), "private/123", []string{"us-east", "us-esat"}); err == nil {
+		t.Error("expected an error for an unknown region")
+	}
+
+	image, err := client.ReplicateImage(context.Background(), "private/123", []string{"us-east", "eu-west"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(image.Regions) != 2 || image.Regions[1].Status != ImageRegionStatusPendingReplication {
+		t.Errorf("unexpected regions %v", image.Regions)
+	}
+
+	image, err = client.WaitForImageRegionsAvailable(context.Background(), "private/123", nil, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if imageRequests != 2 || image.Regions[1].Status != ImageRegionStatusAvailable {
+		t.Errorf("expected all regions to be available after 2 requests, got %v after %d", image.Regions, imageRequests)
+	}
+}
+
+func TestClient_CaptureInstanceImage(t *testing.T) {
+	imageRequests := 0
+	status := "available"
+
+	ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Set("Content-Type", "application/json")
+
+		switch r.Method + " " + r.URL.Path {
+		case "POST /v4/images":
+			body, _ := io.ReadAll(r.Body)
+			if string(body) != `{"disk_id":456,"label":"golden","description":"Baked image"}` {
+				t.Errorf("unexpected body %s", body)
+			}
+
+			rw.Write([]byte(`{"id": "private/123", "label": "golden", "status": "creating"}`))
+		case "GET /v4/images/private/123":
+			imageRequests++
+
+			if imageRequests < 2 {
+				rw.Write([]byte(`{"id": "private/123", "label": "golden", "status": "creating"}`))
+				return
+			}
+
+			rw.Write([]byte(`{"id": "private/123", "label": "golden", "status": "` + status + `"}`))
+		default:
+			rw.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	client := NewClient(nil)
+	client.SetBaseURL(ts.URL)
+	client.SetPollDelay(time.Millisecond)
+
+	image, err := client.CaptureInstanceImage(context.Background(), 456, "golden", "Baked image", 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if image.Status != ImageStatusAvailable || imageRequests != 2 {
+		t.Errorf("expected the image to be available after 2 requests, got %s after %d", image.Status, imageRequests)
+	}
+
+	status = "creating"
+
+	image, err = client.CaptureInstanceImage(context.Background(), 456, "golden", "Baked image", 1)
+	if err == nil || image == nil || image.ID != "private/123" {
+		t.Errorf("expected the created image and an error on timeout, got %+v and %v", image, err)
+	}
+}
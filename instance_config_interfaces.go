@@ -2,10 +2,13 @@ package linodego
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
+	"net"
 )
 
+// VPCIPv4NAT1To1Any assigns the public IPv4 address of the Linode to a VPC interface using 1:1 NAT.
+const VPCIPv4NAT1To1Any = "any"
+
 // InstanceConfigInterface contains information about a configuration's network interface
 type InstanceConfigInterface struct {
 	ID          int                    `json:"id"`
@@ -25,6 +28,53 @@ type VPCIPv4 struct {
 	NAT1To1 string `json:"nat_1_1,omitempty"`
 }
 
+// Validate returns an error if VPC is not an IPv4 address, or NAT1To1 is neither
+// an IPv4 address nor VPCIPv4NAT1To1Any. Empty values are not validated.
+func (v VPCIPv4) Validate() error {
+	if v.VPC != "" && !isIPv4Address(v.VPC) {
+		return fmt.Errorf("invalid VPC IPv4 address %q", v.VPC)
+	}
+
+	if v.NAT1To1 != "" && v.NAT1To1 != VPCIPv4NAT1To1Any && !isIPv4Address(v.NAT1To1) {
+		return fmt.Errorf("invalid 1:1 NAT IPv4 address %q, must be an IPv4 address or %q", v.NAT1To1, VPCIPv4NAT1To1Any)
+	}
+
+	return nil
+}
+
+// ValidateForSubnet validates v and returns an error if VPC is not within the given
+// subnet CIDR, such as VPCSubnet.IPv4.
+func (v VPCIPv4) ValidateForSubnet(subnetCIDR string) error {
+	if err := v.Validate(); err != nil {
+		return err
+	}
+
+	_, subnet, err := net.ParseCIDR(subnetCIDR)
+	if err != nil {
+		return fmt.Errorf("invalid subnet CIDR %q: %w", subnetCIDR, err)
+	}
+
+	if v.VPC != "" && !subnet.Contains(net.ParseIP(v.VPC)) {
+		return fmt.Errorf("VPC IPv4 address %q is not within subnet %s", v.VPC, subnetCIDR)
+	}
+
+	return nil
+}
+
+func isIPv4Address(address string) bool {
+	ip := net.ParseIP(address)
+	return ip != nil && ip.To4() != nil
+}
+
+// validateVPCIPv4 validates ipv4 if it is set.
+func validateVPCIPv4(ipv4 *VPCIPv4) error {
+	if ipv4 == nil {
+		return nil
+	}
+
+	return ipv4.Validate()
+}
+
 type InstanceConfigInterfaceCreateOptions struct {
 	IPAMAddress string                 `json:"ipam_address,omitempty"`
 	Label       string                 `json:"label,omitempty"`
@@ -45,6 +95,35 @@ type InstanceConfigInterfacesReorderOptions struct {
 	IDs []int `json:"ids"`
 }
 
+// NewVPCInterfaceCreateOptions returns the options to create a VPC interface in the given subnet.
+// The VPC IPv4 address is automatically assigned unless IPv4.VPC is set.
+func NewVPCInterfaceCreateOptions(subnetID int) InstanceConfigInterfaceCreateOptions {
+	return InstanceConfigInterfaceCreateOptions{
+		Purpose:  InterfacePurposeVPC,
+		SubnetID: &subnetID,
+	}
+}
+
+// NewVPCInterfaceCreateOptionsWithNAT returns the options to create a VPC interface in the given
+// subnet, that uses the public IPv4 address of the Linode for 1:1 NAT.
+func NewVPCInterfaceCreateOptionsWithNAT(subnetID int) InstanceConfigInterfaceCreateOptions {
+	opts := NewVPCInterfaceCreateOptions(subnetID)
+	opts.IPv4 = &VPCIPv4{NAT1To1: VPCIPv4NAT1To1Any}
+
+	return opts
+}
+
+// validateInstanceConfigInterfaces validates the IPv4 configuration of the given interfaces.
+func validateInstanceConfigInterfaces(interfaces []InstanceConfigInterfaceCreateOptions) error {
+	for _, configInterface := range interfaces {
+		if err := validateVPCIPv4(configInterface.IPv4); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func getInstanceConfigInterfacesCreateOptionsList(
 	interfaces []InstanceConfigInterface,
 ) []InstanceConfigInterfaceCreateOptions {
@@ -110,7 +189,11 @@ func (c *Client) AppendInstanceConfigInterface(
 	configID int,
 	opts InstanceConfigInterfaceCreateOptions,
 ) (*InstanceConfigInterface, error) {
-	body, err := json.Marshal(opts)
+	if err := validateVPCIPv4(opts.IPv4); err != nil {
+		return nil, err
+	}
+
+	body, err := c.marshalJSON(opts)
 	if err != nil {
 		return nil, err
 	}
@@ -170,7 +253,11 @@ func (c *Client) UpdateInstanceConfigInterface(
 	interfaceID int,
 	opts InstanceConfigInterfaceUpdateOptions,
 ) (*InstanceConfigInterface, error) {
-	body, err := json.Marshal(opts)
+	if err := validateVPCIPv4(opts.IPv4); err != nil {
+		return nil, err
+	}
+
+	body, err := c.marshalJSON(opts)
 	if err != nil {
 		return nil, err
 	}
@@ -211,7 +298,7 @@ func (c *Client) ReorderInstanceConfigInterfaces(
 	configID int,
 	opts InstanceConfigInterfacesReorderOptions,
 ) error {
-	body, err := json.Marshal(opts)
+	body, err := c.marshalJSON(opts)
 	if err != nil {
 		return err
 	}
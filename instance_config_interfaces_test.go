@@ -0,0 +1,56 @@
+package linodego
+
+import (
+	"context"
+	"testing"
+)
+
+func TestVPCIPv4Validate(t *testing.T) {
+	for _, tc := range []struct {
+		name       string
+		ipv4       VPCIPv4
+		subnetCIDR string
+		valid      bool
+	}{
+		{"empty", VPCIPv4{}, "", true},
+		{"nat any", VPCIPv4{NAT1To1: VPCIPv4NAT1To1Any}, "", true},
+		{"nat address", VPCIPv4{VPC: "10.0.0.5", NAT1To1: "192.0.2.1"}, "", true},
+		{"malformed nat", VPCIPv4{NAT1To1: "all"}, "", false},
+		{"ipv6 nat", VPCIPv4{NAT1To1: "2001:db8::1"}, "", false},
+		{"malformed vpc", VPCIPv4{VPC: "10.0.0"}, "", false},
+		{"vpc in subnet", VPCIPv4{VPC: "10.0.0.5"}, "10.0.0.0/24", true},
+		{"vpc outside subnet", VPCIPv4{VPC: "10.0.1.5"}, "10.0.0.0/24", false},
+		{"invalid subnet", VPCIPv4{VPC: "10.0.0.5"}, "10.0.0.0", false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.ipv4.Validate()
+			if tc.subnetCIDR != "" {
+				err = tc.ipv4.ValidateForSubnet(tc.subnetCIDR)
+			}
+
+			if (err == nil) != tc.valid {
+				t.Errorf("expected valid to be %t, got error %v", tc.valid, err)
+			}
+		})
+	}
+}
+
+func TestNewVPCInterfaceCreateOptions(t *testing.T) {
+	opts := NewVPCInterfaceCreateOptionsWithNAT(123)
+
+	if opts.Purpose != InterfacePurposeVPC || opts.SubnetID == nil || *opts.SubnetID != 123 {
+		t.Fatalf("unexpected VPC interface options: %#v", opts)
+	}
+
+	if opts.IPv4 == nil || opts.IPv4.NAT1To1 != VPCIPv4NAT1To1Any {
+		t.Fatalf("expected 1:1 NAT to be set to any, got %#v", opts.IPv4)
+	}
+
+	client := NewClient(nil)
+	opts.IPv4.NAT1To1 = "not-an-ip"
+
+	// The request is rejected before it is sent
+	if _, err := client.AppendInstanceConfigInterface(context.Background(), 1, 2, opts); err == nil {
+		t.Error("expected a malformed NAT address to be rejected")
+	}
+}
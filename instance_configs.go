@@ -201,7 +201,11 @@ func (c *Client) GetInstanceConfig(ctx context.Context, linodeID int, configID i
 
 // CreateInstanceConfig creates a new InstanceConfig for the given Instance
 func (c *Client) CreateInstanceConfig(ctx context.Context, linodeID int, opts InstanceConfigCreateOptions) (*InstanceConfig, error) {
-	body, err := json.Marshal(opts)
+	if err := validateInstanceConfigInterfaces(opts.Interfaces); err != nil {
+		return nil, err
+	}
+
+	body, err := c.marshalJSON(opts)
 	if err != nil {
 		return nil, err
 	}
@@ -218,7 +222,11 @@ func (c *Client) CreateInstanceConfig(ctx context.Context, linodeID int, opts In
 
 // UpdateInstanceConfig update an InstanceConfig for the given Instance
 func (c *Client) UpdateInstanceConfig(ctx context.Context, linodeID int, configID int, opts InstanceConfigUpdateOptions) (*InstanceConfig, error) {
-	body, err := json.Marshal(opts)
+	if err := validateInstanceConfigInterfaces(opts.Interfaces); err != nil {
+		return nil, err
+	}
+
+	body, err := c.marshalJSON(opts)
 	if err != nil {
 		return nil, err
 	}
@@ -133,7 +133,7 @@ func (c *Client) GetInstanceDisk(ctx context.Context, linodeID int, diskID int)
 
 // CreateInstanceDisk creates a new InstanceDisk for the given Instance
 func (c *Client) CreateInstanceDisk(ctx context.Context, linodeID int, opts InstanceDiskCreateOptions) (*InstanceDisk, error) {
-	body, err := json.Marshal(opts)
+	body, err := c.marshalJSON(opts)
 	if err != nil {
 		return nil, err
 	}
@@ -150,7 +150,7 @@ func (c *Client) CreateInstanceDisk(ctx context.Context, linodeID int, opts Inst
 
 // UpdateInstanceDisk creates a new InstanceDisk for the given Instance
 func (c *Client) UpdateInstanceDisk(ctx context.Context, linodeID int, diskID int, opts InstanceDiskUpdateOptions) (*InstanceDisk, error) {
-	body, err := json.Marshal(opts)
+	body, err := c.marshalJSON(opts)
 	if err != nil {
 		return nil, err
 	}
@@ -176,7 +176,7 @@ func (c *Client) ResizeInstanceDisk(ctx context.Context, linodeID int, diskID in
 		"size": size,
 	}
 
-	body, err := json.Marshal(opts)
+	body, err := c.marshalJSON(opts)
 	if err != nil {
 		return err
 	}
@@ -187,13 +187,57 @@ func (c *Client) ResizeInstanceDisk(ctx context.Context, linodeID int, diskID in
 	return err
 }
 
+// ResizeInstanceDiskAndWait resizes the Instance disk and waits for the resize event to finish,
+// returning the resized disk once it is ready. The size is validated against the current disk
+// before the resize is requested.
+func (c *Client) ResizeInstanceDiskAndWait(ctx context.Context, linodeID int, diskID int, size int, timeoutSeconds int) (*InstanceDisk, error) {
+	disk, err := c.GetInstanceDisk(ctx, linodeID, diskID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validateInstanceDiskResize(disk, size); err != nil {
+		return nil, err
+	}
+
+	poller, err := c.NewEventPollerWithSecondary(ctx, linodeID, EntityLinode, diskID, ActionDiskResize)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.ResizeInstanceDisk(ctx, linodeID, diskID, size); err != nil {
+		return nil, err
+	}
+
+	if _, err := poller.WaitForFinished(ctx, timeoutSeconds); err != nil {
+		return nil, fmt.Errorf("failed to wait for disk %d to be resized: %w", diskID, err)
+	}
+
+	return c.WaitForInstanceDiskStatus(ctx, linodeID, diskID, DiskReady, timeoutSeconds)
+}
+
+// validateInstanceDiskResize checks that disk can be resized to size before making a request.
+// The API does not report the used space of a disk, so a resize below it can only be
+// rejected by the API.
+func validateInstanceDiskResize(disk *InstanceDisk, size int) error {
+	if size < 1 {
+		return fmt.Errorf("invalid size %d for disk %d, must be a positive number of MB", size, disk.ID)
+	}
+
+	if disk.Status == DiskDeleting {
+		return fmt.Errorf("cannot resize disk %d while it is being deleted", disk.ID)
+	}
+
+	return nil
+}
+
 // PasswordResetInstanceDisk resets the "root" account password on the Instance disk
 func (c *Client) PasswordResetInstanceDisk(ctx context.Context, linodeID int, diskID int, password string) error {
 	opts := map[string]any{
 		"password": password,
 	}
 
-	body, err := json.Marshal(opts)
+	body, err := c.marshalJSON(opts)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,63 @@
+package linodego
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestClient_ResizeInstanceDiskAndWait(t *testing.T) {
+	resized := false
+	eventRequests := 0
+
+	ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/v4/linode/instances/123/disks/456":
+			rw.Write([]byte(`{"id": 456, "status": "ready", "size": 1000, "filesystem": "ext4"}`))
+		case "/v4/linode/instances/123/disks/456/resize":
+			resized = true
+			rw.Write([]byte(`{}`))
+		case "/v4/account/events":
+			eventRequests++
+
+			if !resized {
+				rw.Write([]byte(`{"data": [], "page": 1, "pages": 1, "results": 0}`))
+				return
+			}
+
+			rw.Write([]byte(`{"data": [{"id": 7, "action": "disk_resize", "status": "started",
+				"entity": {"id": 123, "type": "linode"}, "secondary_entity": {"id": 456, "type": "disk"}}],
+				"page": 1, "pages": 1, "results": 1}`))
+		case "/v4/account/events/7":
+			rw.Write([]byte(`{"id": 7, "action": "disk_resize", "status": "finished"}`))
+		case "/v4/linode/instances/123/disks":
+			rw.Write([]byte(`{"data": [{"id": 456, "status": "ready", "size": 2000}], "page": 1, "pages": 1, "results": 1}`))
+		default:
+			rw.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	client := NewClient(nil)
+	client.SetBaseURL(ts.URL)
+	client.SetPollDelay(time.Millisecond)
+
+	disk, err := client.ResizeInstanceDiskAndWait(context.Background(), 123, 456, 2000, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !resized || disk.Size != 2000 || eventRequests < 2 {
+		t.Errorf("expected the resized disk after the resize event, got %#v", disk)
+	}
+
+	_, err = client.ResizeInstanceDiskAndWait(context.Background(), 123, 456, 0, 5)
+	if err == nil || !strings.Contains(err.Error(), "must be a positive number") {
+		t.Errorf("expected a validation error, got %v", err)
+	}
+}
@@ -62,6 +62,26 @@ var (
 	SnapshotUserAborted         InstanceSnapshotStatus = "userAborted"
 )
 
+// Backup schedule days accepted as InstanceBackup.Schedule.Day. BackupScheduleScheduling
+// is also returned as the day and window of a schedule that has not been assigned yet,
+// letting the API choose the time of the backups.
+const (
+	BackupScheduleScheduling = "Scheduling"
+	BackupScheduleSunday     = "Sunday"
+	BackupScheduleMonday     = "Monday"
+	BackupScheduleTuesday    = "Tuesday"
+	BackupScheduleWednesday  = "Wednesday"
+	BackupScheduleThursday   = "Thursday"
+	BackupScheduleFriday     = "Friday"
+	BackupScheduleSaturday   = "Saturday"
+)
+
+// BackupScheduleWindow returns the InstanceBackup.Schedule.Window of the two hour window
+// starting at the given UTC hour, which must be even, e.g. "W10" for 10:00 to 12:00 UTC.
+func BackupScheduleWindow(hour int) string {
+	return fmt.Sprintf("W%d", hour)
+}
+
 // UnmarshalJSON implements the json.Unmarshaler interface
 func (i *InstanceSnapshot) UnmarshalJSON(b []byte) error {
 	type Mask InstanceSnapshot
@@ -99,7 +119,7 @@ func (c *Client) GetInstanceSnapshot(ctx context.Context, linodeID int, snapshot
 
 // CreateInstanceSnapshot Creates or Replaces the snapshot Backup of a Linode. If a previous snapshot exists for this Linode, it will be deleted.
 func (c *Client) CreateInstanceSnapshot(ctx context.Context, linodeID int, label string) (*InstanceSnapshot, error) {
-	body, err := json.Marshal(map[string]string{"label": label})
+	body, err := c.marshalJSON(map[string]string{"label": label})
 	if err != nil {
 		return nil, err
 	}
@@ -113,6 +133,41 @@ func (c *Client) CreateInstanceSnapshot(ctx context.Context, linodeID int, label
 	return r.Result().(*InstanceSnapshot), nil
 }
 
+// WaitForInstanceSnapshot waits for the linode_snapshot event of a snapshot created with
+// CreateInstanceSnapshot to finish and returns the snapshot. It will timeout with an error
+// after timeoutSeconds. If the snapshot fails, the failed snapshot and the error are returned.
+func (c *Client) WaitForInstanceSnapshot(ctx context.Context, linodeID int, snapshotID int, timeoutSeconds int) (*InstanceSnapshot, error) {
+	snapshot, err := c.GetInstanceSnapshot(ctx, linodeID, snapshotID)
+	if err != nil {
+		return nil, err
+	}
+
+	if snapshot.Status == SnapshotSuccessful {
+		return snapshot, nil
+	}
+
+	// The event may be created slightly before the snapshot
+	minStart := time.Now()
+	if snapshot.Created != nil {
+		minStart = snapshot.Created.Add(-5 * time.Second)
+	}
+
+	if _, err := c.WaitForEventFinished(ctx, linodeID, EntityLinode, ActionLinodeSnapshot, minStart, timeoutSeconds); err != nil {
+		return snapshot, err
+	}
+
+	snapshot, err = c.GetInstanceSnapshot(ctx, linodeID, snapshotID)
+	if err != nil {
+		return nil, err
+	}
+
+	if snapshot.Status != SnapshotSuccessful {
+		return snapshot, fmt.Errorf("snapshot %d of Linode %d finished with status %q", snapshotID, linodeID, snapshot.Status)
+	}
+
+	return snapshot, nil
+}
+
 // GetInstanceBackups gets the Instance's available Backups.
 // This is not called ListInstanceBackups because a single object is returned, matching the API response.
 func (c *Client) GetInstanceBackups(ctx context.Context, linodeID int) (*InstanceBackupsResponse, error) {
@@ -141,7 +196,7 @@ func (c *Client) CancelInstanceBackups(ctx context.Context, linodeID int) error
 
 // RestoreInstanceBackup Restores a Linode's Backup to the specified Linode.
 func (c *Client) RestoreInstanceBackup(ctx context.Context, linodeID int, backupID int, opts RestoreInstanceOptions) error {
-	body, err := json.Marshal(opts)
+	body, err := c.marshalJSON(opts)
 	if err != nil {
 		return NewError(err)
 	}
@@ -0,0 +1,49 @@
+package linodego
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestClient_WaitForInstanceSnapshot(t *testing.T) {
+	eventStatus := "started"
+
+	ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/v4/account/events":
+			rw.Write([]byte(`{"data": [{"id": 1, "action": "linode_snapshot", "status": "` + eventStatus + `",
+				"entity": {"id": 123, "type": "linode"}}], "page": 1, "pages": 1, "results": 1}`))
+
+			// The event finishes once it has been observed in progress
+			eventStatus = "finished"
+		case "/v4/linode/instances/123/backups/456":
+			status := "pending"
+			if eventStatus == "finished" {
+				status = "successful"
+			}
+
+			rw.Write([]byte(`{"id": 456, "label": "before-upgrade", "status": "` + status + `", "created": "2024-01-01T00:00:00"}`))
+		default:
+			rw.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	client := NewClient(nil)
+	client.SetBaseURL(ts.URL)
+	client.SetPollDelay(time.Millisecond)
+
+	snapshot, err := client.WaitForInstanceSnapshot(context.Background(), 123, 456, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if snapshot.Status != SnapshotSuccessful {
+		t.Errorf("expected the snapshot to be successful, got %q", snapshot.Status)
+	}
+}
@@ -33,6 +33,53 @@ type InstanceStats struct {
 	Data  InstanceStatsData `json:"data"`
 }
 
+// MonthlyInstanceTransferStats represents the network transfer of a Linode Instance during a month
+type MonthlyInstanceTransferStats struct {
+	// Bytes of inbound transfer
+	BytesIn int `json:"bytes_in"`
+
+	// Bytes of outbound transfer
+	BytesOut int `json:"bytes_out"`
+
+	// Bytes of inbound and outbound transfer
+	BytesTotal int `json:"bytes_total"`
+}
+
+// CPUPoints returns the CPU usage time series as data points
+func (d InstanceStatsData) CPUPoints() []StatsDataPoint {
+	return parseStatsDataPoints(d.CPU)
+}
+
+// IOPoints returns the disk IO time series as data points
+func (s StatsIO) IOPoints() []StatsDataPoint {
+	return parseStatsDataPoints(s.IO)
+}
+
+// SwapPoints returns the swap IO time series as data points
+func (s StatsIO) SwapPoints() []StatsDataPoint {
+	return parseStatsDataPoints(s.Swap)
+}
+
+// InPoints returns the public inbound traffic time series as data points
+func (s StatsNet) InPoints() []StatsDataPoint {
+	return parseStatsDataPoints(s.In)
+}
+
+// OutPoints returns the public outbound traffic time series as data points
+func (s StatsNet) OutPoints() []StatsDataPoint {
+	return parseStatsDataPoints(s.Out)
+}
+
+// PrivateInPoints returns the private inbound traffic time series as data points
+func (s StatsNet) PrivateInPoints() []StatsDataPoint {
+	return parseStatsDataPoints(s.PrivateIn)
+}
+
+// PrivateOutPoints returns the private outbound traffic time series as data points
+func (s StatsNet) PrivateOutPoints() []StatsDataPoint {
+	return parseStatsDataPoints(s.PrivateOut)
+}
+
 // GetInstanceStats gets the template with the provided ID
 func (c *Client) GetInstanceStats(ctx context.Context, linodeID int) (*InstanceStats, error) {
 	e := fmt.Sprintf("linode/instances/%d/stats", linodeID)
@@ -54,3 +101,39 @@ func (c *Client) GetInstanceStatsByDate(ctx context.Context, linodeID int, year
 	}
 	return r.Result().(*InstanceStats), nil
 }
+
+// GetInstanceStatsByMonth gets the stats of a Linode Instance for the given year and month (1-12)
+func (c *Client) GetInstanceStatsByMonth(ctx context.Context, linodeID int, year int, month int) (*InstanceStats, error) {
+	if err := validateStatsMonth(year, month); err != nil {
+		return nil, err
+	}
+
+	return c.GetInstanceStatsByDate(ctx, linodeID, year, month)
+}
+
+// GetInstanceTransferByMonth gets the network transfer of a Linode Instance for the given year and month (1-12)
+func (c *Client) GetInstanceTransferByMonth(ctx context.Context, linodeID int, year int, month int) (*MonthlyInstanceTransferStats, error) {
+	if err := validateStatsMonth(year, month); err != nil {
+		return nil, err
+	}
+
+	e := fmt.Sprintf("linode/instances/%d/transfer/%d/%d", linodeID, year, month)
+	req := c.R(ctx).SetResult(&MonthlyInstanceTransferStats{})
+	r, err := coupleAPIErrors(req.Get(e))
+	if err != nil {
+		return nil, err
+	}
+	return r.Result().(*MonthlyInstanceTransferStats), nil
+}
+
+func validateStatsMonth(year int, month int) error {
+	if month < 1 || month > 12 {
+		return fmt.Errorf("invalid month %d, must be between 1 and 12", month)
+	}
+
+	if year < 2000 {
+		return fmt.Errorf("invalid year %d", year)
+	}
+
+	return nil
+}
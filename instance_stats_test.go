@@ -0,0 +1,52 @@
+package linodego
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestClient_GetInstanceTransferByMonth(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v4/linode/instances/123/transfer/2024/2" {
+			rw.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(`{"bytes_in": 30, "bytes_out": 12, "bytes_total": 42}`))
+	}))
+	defer ts.Close()
+
+	client := NewClient(nil)
+	client.SetBaseURL(ts.URL)
+
+	transfer, err := client.GetInstanceTransferByMonth(context.Background(), 123, 2024, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if transfer.BytesIn != 30 || transfer.BytesOut != 12 || transfer.BytesTotal != 42 {
+		t.Errorf("unexpected transfer: %+v", transfer)
+	}
+
+	if _, err := client.GetInstanceStatsByMonth(context.Background(), 123, 2024, 13); err == nil {
+		t.Error("expected an invalid month to fail")
+	}
+}
+
+func TestInstanceStatsData_Points(t *testing.T) {
+	data := InstanceStatsData{
+		CPU:   [][]float64{{1700000000000, 12.5}},
+		NetV4: StatsNet{PrivateOut: [][]float64{{1700000000000, 1}, {1700000300000, 2}}},
+	}
+
+	if cpu := data.CPUPoints(); len(cpu) != 1 || cpu[0].Value != 12.5 {
+		t.Errorf("unexpected CPU points: %v", cpu)
+	}
+
+	if out := data.NetV4.PrivateOutPoints(); len(out) != 2 || out[1].Value != 2 {
+		t.Errorf("unexpected private outbound points: %v", out)
+	}
+}
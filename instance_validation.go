@@ -0,0 +1,214 @@
+package linodego
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+const (
+	instanceLabelMinLength = 3
+	instanceLabelMaxLength = 64
+)
+
+// Validate checks the options for common mistakes before they are sent to CreateInstance,
+// e.g. a missing region or type, an invalid label, an image without a root password or
+// more than one Linode interface set as the default IPv4 or IPv6 route.
+// All of the problems found are returned as a single error.
+// Use ValidateInstanceCreateOptions to also check the region and type against the API.
+func (opts InstanceCreateOptions) Validate() error {
+	var errs []error
+
+	if opts.Region == "" {
+		errs = append(errs, errors.New("region is required"))
+	}
+
+	if opts.Type == "" {
+		errs = append(errs, errors.New("type is required"))
+	}
+
+	if opts.Label != "" {
+		if err := validateInstanceLabel(opts.Label); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if opts.Image != "" && opts.RootPass == "" {
+		errs = append(errs, errors.New("root_pass is required when an image is provided"))
+	}
+
+	if opts.Image != "" && opts.BackupID != 0 {
+		errs = append(errs, errors.New("image and backup_id cannot both be provided"))
+	}
+
+	if err := validateInstanceConfigInterfaces(opts.Interfaces); err != nil {
+		errs = append(errs, err)
+	}
+
+	if len(opts.LinodeInterfaces) > 0 {
+		if len(opts.Interfaces) > 0 {
+			errs = append(errs, errors.New("interfaces and Linode interfaces cannot both be provided"))
+		}
+
+		if opts.InterfaceGeneration == InterfaceGenerationLegacyConfig {
+			errs = append(errs, fmt.Errorf("interface generation %q does not support Linode interfaces", opts.InterfaceGeneration))
+		}
+
+		if err := validateLinodeInterfaces(opts.LinodeInterfaces); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// Validate checks the backup schedule of the options before they are sent to UpdateInstance.
+// The day must be one of the BackupSchedule constants and the window must be
+// BackupScheduleScheduling or a BackupScheduleWindow, either may be left empty.
+func (opts InstanceUpdateOptions) Validate() error {
+	if opts.Backups == nil {
+		return nil
+	}
+
+	return validateInstanceBackupSchedule(opts.Backups.Schedule.Day, opts.Backups.Schedule.Window)
+}
+
+// validateInstanceBackupSchedule checks the day and window of a backup schedule
+func validateInstanceBackupSchedule(day, window string) error {
+	var errs []error
+
+	switch day {
+	case "", BackupScheduleScheduling, BackupScheduleSunday, BackupScheduleMonday, BackupScheduleTuesday,
+		BackupScheduleWednesday, BackupScheduleThursday, BackupScheduleFriday, BackupScheduleSaturday:
+	default:
+		errs = append(errs, fmt.Errorf("invalid backup schedule day %q, must be a day of the week or %q", day, BackupScheduleScheduling))
+	}
+
+	if window != "" && window != BackupScheduleScheduling {
+		valid := false
+
+		for hour := 0; hour < 24; hour += 2 {
+			if window == BackupScheduleWindow(hour) {
+				valid = true
+				break
+			}
+		}
+
+		if !valid {
+			errs = append(errs, fmt.Errorf("invalid backup schedule window %q, must be one of W0, W2, ..., W22 or %q", window, BackupScheduleScheduling))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// validateInstanceLabel checks that the label is 3 to 64 characters of letters, numbers, dashes,
+// underscores and periods that begins and ends with a letter or number, without repeated dashes,
+// underscores or periods.
+func validateInstanceLabel(label string) error {
+	invalid := func(reason string) error {
+		return fmt.Errorf("invalid label %q, %s", label, reason)
+	}
+
+	if len(label) < instanceLabelMinLength || len(label) > instanceLabelMaxLength {
+		return invalid(fmt.Sprintf("must be %d to %d characters", instanceLabelMinLength, instanceLabelMaxLength))
+	}
+
+	isAlphanumeric := func(r byte) bool {
+		return (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9')
+	}
+
+	if !isAlphanumeric(label[0]) || !isAlphanumeric(label[len(label)-1]) {
+		return invalid("must begin and end with a letter or number")
+	}
+
+	for i := 0; i < len(label); i++ {
+		if isAlphanumeric(label[i]) {
+			continue
+		}
+
+		if !strings.ContainsRune("-_.", rune(label[i])) {
+			return invalid("must only contain letters, numbers, dashes, underscores and periods")
+		}
+
+		if label[i] == label[i-1] {
+			return invalid("must not contain repeated dashes, underscores or periods")
+		}
+	}
+
+	return nil
+}
+
+// ValidateInstanceCreateOptions checks the options with InstanceCreateOptions.Validate and checks
+// that the region supports Linodes and the type exists and is available in the region,
+// using ListRegions, ListTypes and IsTypeAvailable. Enable response caching with UseCache
+// to avoid listing them for every validation. All of the problems found are returned as a single error.
+func (c *Client) ValidateInstanceCreateOptions(ctx context.Context, opts InstanceCreateOptions) error {
+	var errs []error
+
+	if err := opts.Validate(); err != nil {
+		errs = append(errs, err)
+	}
+
+	var regionErr error
+
+	if opts.Region != "" {
+		regions, err := c.ListRegions(ctx, nil)
+		if err != nil {
+			return err
+		}
+
+		regionErr = validateInstanceRegion(regions, opts.Region)
+		errs = append(errs, regionErr)
+	}
+
+	if opts.Type != "" {
+		types, err := c.ListTypes(ctx, nil)
+		if err != nil {
+			return err
+		}
+
+		errs = append(errs, validateInstanceType(types, opts.Type))
+	}
+
+	// The availability is only fetched for regions supporting Linodes
+	if opts.Region != "" && opts.Type != "" && regionErr == nil {
+		available, err := c.IsTypeAvailable(ctx, opts.Region, opts.Type)
+		if err != nil {
+			return err
+		}
+
+		if !available {
+			errs = append(errs, fmt.Errorf("type %q is not available in region %q", opts.Type, opts.Region))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func validateInstanceRegion(regions []Region, regionID string) error {
+	for _, region := range regions {
+		if region.ID != regionID {
+			continue
+		}
+
+		if !region.HasCapability(CapabilityLinodes) {
+			return fmt.Errorf("region %q does not support Linodes", regionID)
+		}
+
+		return nil
+	}
+
+	return fmt.Errorf("unknown region %q", regionID)
+}
+
+func validateInstanceType(types []LinodeType, typeID string) error {
+	for _, linodeType := range types {
+		if linodeType.ID == typeID {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("unknown type %q", typeID)
+}
@@ -0,0 +1,137 @@
+package linodego
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestInstanceCreateOptions_Validate(t *testing.T) {
+	valid := InstanceCreateOptions{Region: "us-east", Type: "g6-nanode-1", Label: "web-01.example", Image: "linode/debian12", RootPass: "secret"}
+	if err := valid.Validate(); err != nil {
+		t.Errorf("expected %+v to be valid, got %v", valid, err)
+	}
+
+	for _, label := range []string{"ab", strings.Repeat("a", 65), "-web", "web-", "web--01", "web 01"} {
+		opts := InstanceCreateOptions{Region: "us-east", Type: "g6-nanode-1", Label: label}
+		if err := opts.Validate(); err == nil {
+			t.Errorf("expected label %q to be invalid", label)
+		}
+	}
+
+	err := InstanceCreateOptions{Label: "x", Image: "linode/debian12", BackupID: 123}.Validate()
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+
+	for _, problem := range []string{"region is required", "type is required", `invalid label "x"`, "root_pass is required", "backup_id"} {
+		if !strings.Contains(err.Error(), problem) {
+			t.Errorf("expected %q in %q", problem, err)
+		}
+	}
+}
+
+func TestInstanceUpdateOptions_Validate(t *testing.T) {
+	for _, schedule := range [][2]string{
+		{"", ""},
+		{BackupScheduleScheduling, BackupScheduleScheduling},
+		{BackupScheduleSunday, BackupScheduleWindow(0)},
+		{BackupScheduleSaturday, BackupScheduleWindow(22)},
+	} {
+		opts := InstanceUpdateOptions{Backups: &InstanceBackup{}}
+		opts.Backups.Schedule.Day, opts.Backups.Schedule.Window = schedule[0], schedule[1]
+
+		if err := opts.Validate(); err != nil {
+			t.Errorf("expected schedule %v to be valid, got %v", schedule, err)
+		}
+	}
+
+	for _, schedule := range [][2]string{
+		{"sunday", ""},
+		{"", "W1"},
+		{"", "W24"},
+		{"", "10"},
+	} {
+		opts := InstanceUpdateOptions{Backups: &InstanceBackup{}}
+		opts.Backups.Schedule.Day, opts.Backups.Schedule.Window = schedule[0], schedule[1]
+
+		if err := opts.Validate(); err == nil {
+			t.Errorf("expected schedule %v to be invalid", schedule)
+		}
+	}
+}
+
+func TestClient_CreateInstance_validation(t *testing.T) {
+	requests := 0
+
+	ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		requests++
+
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(`{"id": 123}`))
+	}))
+	defer ts.Close()
+
+	client := NewClient(nil)
+	client.SetBaseURL(ts.URL)
+
+	if _, err := client.CreateInstance(context.Background(), InstanceCreateOptions{Type: "g6-nanode-1"}); err == nil {
+		t.Error("expected a validation error")
+	}
+
+	if requests != 0 {
+		t.Errorf("expected invalid options not to be sent, got %d requests", requests)
+	}
+
+	client.SetClientValidation(false)
+
+	if _, err := client.CreateInstance(context.Background(), InstanceCreateOptions{Type: "g6-nanode-1"}); err != nil {
+		t.Fatal(err)
+	}
+
+	if requests != 1 {
+		t.Errorf("expected the options to be sent without validation, got %d requests", requests)
+	}
+}
+
+func TestClient_ValidateInstanceCreateOptions(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/v4/regions":
+			rw.Write([]byte(`{"data": [{"id": "us-east", "capabilities": ["Linodes"]}, {"id": "us-storage", "capabilities": ["Object Storage"]}], "page": 1, "pages": 1, "results": 2}`))
+		case "/v4/linode/types":
+			rw.Write([]byte(`{"data": [{"id": "g6-nanode-1"}, {"id": "g1-gpu-rtx6000-1"}], "page": 1, "pages": 1, "results": 2}`))
+		case "/v4/regions/us-east/availability":
+			rw.Write([]byte(`[{"region": "us-east", "plan": "g1-gpu-rtx6000-1", "available": false}]`))
+		default:
+			rw.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	client := NewClient(nil)
+	client.SetBaseURL(ts.URL)
+
+	if err := client.ValidateInstanceCreateOptions(context.Background(), InstanceCreateOptions{Region: "us-east", Type: "g6-nanode-1"}); err != nil {
+		t.Errorf("expected the options to be valid, got %v", err)
+	}
+
+	for _, tc := range []struct {
+		opts    InstanceCreateOptions
+		problem string
+	}{
+		{InstanceCreateOptions{Region: "us-esat", Type: "g6-nanode-1"}, `unknown region "us-esat"`},
+		{InstanceCreateOptions{Region: "us-storage", Type: "g6-nanode-1"}, "does not support Linodes"},
+		{InstanceCreateOptions{Region: "us-east", Type: "g6-nanode-2"}, `unknown type "g6-nanode-2"`},
+		{InstanceCreateOptions{Region: "us-east", Type: "g1-gpu-rtx6000-1"}, "is not available in region"},
+	} {
+		err := client.ValidateInstanceCreateOptions(context.Background(), tc.opts)
+		if err == nil || !strings.Contains(err.Error(), tc.problem) {
+			t.Errorf("expected %q for %+v, got %v", tc.problem, tc.opts, err)
+		}
+	}
+}
@@ -63,6 +63,28 @@ type Instance struct {
 	Specs           *InstanceSpec   `json:"specs"`
 	WatchdogEnabled bool            `json:"watchdog_enabled"`
 	Tags            []string        `json:"tags"`
+
+	// PlacementGroup is the PlacementGroup the Instance is assigned to, if any
+	PlacementGroup *InstancePlacementGroup `json:"placement_group"`
+
+	// InterfaceGeneration is the networking model of the Instance
+	InterfaceGeneration InterfaceGeneration `json:"interface_generation"`
+}
+
+// InstancePlacementGroup represents the PlacementGroup an Instance is assigned to
+type InstancePlacementGroup struct {
+	ID                   int                  `json:"id"`
+	Label                string               `json:"label"`
+	PlacementGroupType   PlacementGroupType   `json:"placement_group_type"`
+	PlacementGroupPolicy PlacementGroupPolicy `json:"placement_group_policy"`
+}
+
+// InstanceCreatePlacementGroupOptions specifies the PlacementGroup to assign a new Instance to
+type InstanceCreatePlacementGroupOptions struct {
+	ID int `json:"id"`
+
+	// CompliantOnly only creates the Instance if the group remains compliant
+	CompliantOnly *bool `json:"compliant_only,omitempty"`
 }
 
 // InstanceSpec represents a linode spec
@@ -131,10 +153,40 @@ type InstanceCreateOptions struct {
 	Tags            []string                               `json:"tags,omitempty"`
 	Metadata        *InstanceMetadataOptions               `json:"metadata,omitempty"`
 	FirewallID      int                                    `json:"firewall_id,omitempty"`
+	PlacementGroup  *InstanceCreatePlacementGroupOptions   `json:"placement_group,omitempty"`
 
 	// Creation fields that need to be set explicitly false, "", or 0 use pointers
 	SwapSize *int  `json:"swap_size,omitempty"`
 	Booted   *bool `json:"booted,omitempty"`
+
+	// InterfaceGeneration is the networking model of the Instance, which defaults to
+	// InterfaceGenerationLinode when LinodeInterfaces are set
+	InterfaceGeneration InterfaceGeneration `json:"interface_generation,omitempty"`
+
+	// LinodeInterfaces are the interfaces of an Instance using the Linode Interfaces networking model.
+	// They are sent as the interfaces of the Instance, so they cannot be combined with Interfaces.
+	LinodeInterfaces []LinodeInterfaceCreateOptions `json:"-"`
+}
+
+// MarshalJSON implements the json.Marshaler interface, sending LinodeInterfaces as the interfaces of the Instance
+func (opts InstanceCreateOptions) MarshalJSON() ([]byte, error) {
+	type Mask InstanceCreateOptions
+
+	if len(opts.LinodeInterfaces) == 0 {
+		return json.Marshal(Mask(opts))
+	}
+
+	if opts.InterfaceGeneration == "" {
+		opts.InterfaceGeneration = InterfaceGenerationLinode
+	}
+
+	return json.Marshal(struct {
+		Mask
+		Interfaces []LinodeInterfaceCreateOptions `json:"interfaces"`
+	}{
+		Mask:       Mask(opts),
+		Interfaces: opts.LinodeInterfaces,
+	})
 }
 
 // InstanceUpdateOptions is an options struct used when Updating an Instance
@@ -206,10 +258,15 @@ type InstanceResizeOptions struct {
 	AllowAutoDiskResize *bool `json:"allow_auto_disk_resize,omitempty"`
 }
 
-// InstanceResizeOptions is an options struct used when resizing an instance
+// InstanceMigrateOptions is an options struct used when migrating an instance
 type InstanceMigrateOptions struct {
-	Type   InstanceMigrationType `json:"type,omitempty"`
-	Region string                `json:"region,omitempty"`
+	Type InstanceMigrationType `json:"type,omitempty"`
+
+	// Region is the target of a migration to a different region
+	Region string `json:"region,omitempty"`
+
+	// PlacementGroup is the PlacementGroup to assign the instance to in the target region
+	PlacementGroup *InstanceCreatePlacementGroupOptions `json:"placement_group,omitempty"`
 }
 
 // InstancesPagedResponse represents a linode API response for listing
@@ -265,9 +322,16 @@ func (c *Client) GetInstanceTransfer(ctx context.Context, linodeID int) (*Instan
 	return r.Result().(*InstanceTransfer), nil
 }
 
-// CreateInstance creates a Linode instance
+// CreateInstance creates a Linode instance. The options are checked with InstanceCreateOptions.Validate
+// before they are sent, unless client validation is disabled with SetClientValidation.
 func (c *Client) CreateInstance(ctx context.Context, opts InstanceCreateOptions) (*Instance, error) {
-	body, err := json.Marshal(opts)
+	if !c.skipValidation {
+		if err := opts.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	body, err := c.marshalJSON(opts)
 	if err != nil {
 		return nil, err
 	}
@@ -281,9 +345,16 @@ func (c *Client) CreateInstance(ctx context.Context, opts InstanceCreateOptions)
 	return r.Result().(*Instance), nil
 }
 
-// UpdateInstance creates a Linode instance
+// UpdateInstance updates a Linode instance. The options are checked with InstanceUpdateOptions.Validate
+// before they are sent, unless client validation is disabled with SetClientValidation.
 func (c *Client) UpdateInstance(ctx context.Context, linodeID int, opts InstanceUpdateOptions) (*Instance, error) {
-	body, err := json.Marshal(opts)
+	if !c.skipValidation {
+		if err := opts.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	body, err := c.marshalJSON(opts)
 	if err != nil {
 		return nil, err
 	}
@@ -315,7 +386,7 @@ func (c *Client) BootInstance(ctx context.Context, linodeID int, configID int) e
 	var body string
 	if configID != 0 {
 		bodyMap := map[string]int{"config_id": configID}
-		bodyJSON, err := json.Marshal(bodyMap)
+		bodyJSON, err := c.marshalJSON(bodyMap)
 		if err != nil {
 			return err
 		}
@@ -326,9 +397,43 @@ func (c *Client) BootInstance(ctx context.Context, linodeID int, configID int) e
 	return err
 }
 
+// BootInstanceAndWait boots the Instance into the config with the given ID and waits for it to be
+// running, see WaitForInstanceStatusViaEvents. A configID of 0 boots the default config, such as the
+// only config of the Instance. The config is checked to belong to the Instance before booting it.
+// It will timeout with an error after timeoutSeconds.
+func (c *Client) BootInstanceAndWait(ctx context.Context, linodeID int, configID int, timeoutSeconds int) (*Instance, error) {
+	configs, err := c.ListInstanceConfigs(ctx, linodeID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(configs) == 0 {
+		return nil, fmt.Errorf("no configs to boot Instance %d with", linodeID)
+	}
+
+	if configID != 0 {
+		found := false
+		for _, config := range configs {
+			found = found || config.ID == configID
+		}
+
+		if !found {
+			return nil, fmt.Errorf("config %d does not belong to Instance %d", configID, linodeID)
+		}
+	}
+
+	start := time.Now()
+
+	if err := c.BootInstance(ctx, linodeID, configID); err != nil {
+		return nil, err
+	}
+
+	return c.WaitForInstanceStatusViaEvents(ctx, linodeID, InstanceRunning, start, timeoutSeconds)
+}
+
 // CloneInstance clone an existing Instances Disks and Configuration profiles to another Linode Instance
 func (c *Client) CloneInstance(ctx context.Context, linodeID int, opts InstanceCloneOptions) (*Instance, error) {
-	body, err := json.Marshal(opts)
+	body, err := c.marshalJSON(opts)
 	if err != nil {
 		return nil, err
 	}
@@ -350,7 +455,7 @@ func (c *Client) RebootInstance(ctx context.Context, linodeID int, configID int)
 
 	if configID != 0 {
 		bodyMap := map[string]int{"config_id": configID}
-		bodyJSON, err := json.Marshal(bodyMap)
+		bodyJSON, err := c.marshalJSON(bodyMap)
 		if err != nil {
 			return err
 		}
@@ -377,7 +482,7 @@ type InstanceRebuildOptions struct {
 // RebuildInstance Deletes all Disks and Configs on this Linode,
 // then deploys a new Image to this Linode with the given attributes.
 func (c *Client) RebuildInstance(ctx context.Context, linodeID int, opts InstanceRebuildOptions) (*Instance, error) {
-	body, err := json.Marshal(opts)
+	body, err := c.marshalJSON(opts)
 	if err != nil {
 		return nil, err
 	}
@@ -400,7 +505,7 @@ type InstanceRescueOptions struct {
 // You can also use Rescue Mode for tasks other than disaster recovery, such as formatting disks to use different filesystems,
 // copying data between disks, and downloading files from a disk via SSH and SFTP.
 func (c *Client) RescueInstance(ctx context.Context, linodeID int, opts InstanceRescueOptions) error {
-	body, err := json.Marshal(opts)
+	body, err := c.marshalJSON(opts)
 	if err != nil {
 		return err
 	}
@@ -411,7 +516,7 @@ func (c *Client) RescueInstance(ctx context.Context, linodeID int, opts Instance
 
 // ResizeInstance resizes an instance to new Linode type
 func (c *Client) ResizeInstance(ctx context.Context, linodeID int, opts InstanceResizeOptions) error {
-	body, err := json.Marshal(opts)
+	body, err := c.marshalJSON(opts)
 	if err != nil {
 		return err
 	}
@@ -430,9 +535,17 @@ func (c *Client) MutateInstance(ctx context.Context, id int) error {
 	return c.simpleInstanceAction(ctx, "mutate", id)
 }
 
-// MigrateInstance - Migrate an instance
+// MigrateInstance - Migrate an instance.
+// If a target region is given, the instance type is first checked to be available in that region.
+// Use WaitForInstanceMigration with the time before the migration to wait for it to complete.
 func (c *Client) MigrateInstance(ctx context.Context, linodeID int, opts InstanceMigrateOptions) error {
-	body, err := json.Marshal(opts)
+	if opts.Region != "" {
+		if err := c.validateInstanceMigrationRegion(ctx, linodeID, opts.Region); err != nil {
+			return err
+		}
+	}
+
+	body, err := c.marshalJSON(opts)
 	if err != nil {
 		return err
 	}
@@ -441,6 +554,34 @@ func (c *Client) MigrateInstance(ctx context.Context, linodeID int, opts Instanc
 	return err
 }
 
+// validateInstanceMigrationRegion checks that the type of an instance can be deployed in the target region
+func (c *Client) validateInstanceMigrationRegion(ctx context.Context, linodeID int, regionID string) error {
+	instance, err := c.GetInstance(ctx, linodeID)
+	if err != nil {
+		return err
+	}
+
+	region, err := c.GetRegion(ctx, regionID)
+	if err != nil {
+		return err
+	}
+
+	if !region.HasCapability(CapabilityLinodes) {
+		return fmt.Errorf("region %s does not support Linodes", regionID)
+	}
+
+	linodeType, err := c.GetType(ctx, instance.Type)
+	if err != nil {
+		return err
+	}
+
+	if linodeType.Class == ClassGPU && !region.HasCapability(CapabilityGPU) {
+		return fmt.Errorf("region %s does not support instance type %s", regionID, instance.Type)
+	}
+
+	return nil
+}
+
 // simpleInstanceAction is a helper for Instance actions that take no parameters
 // and return empty responses `{}` unless they return a standard error
 func (c *Client) simpleInstanceAction(ctx context.Context, action string, linodeID int) error {
@@ -0,0 +1,93 @@
+package linodego
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestClient_MigrateInstance_unsupportedRegion(t *testing.T) {
+	migrated := false
+
+	ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/v4/linode/instances/123":
+			rw.Write([]byte(`{"id": 123, "region": "us-east", "type": "g1-gpu-rtx6000-1"}`))
+		case "/v4/regions/us-west":
+			rw.Write([]byte(`{"id": "us-west", "capabilities": ["Linodes", "Block Storage"]}`))
+		case "/v4/regions/us-southeast":
+			rw.Write([]byte(`{"id": "us-southeast", "capabilities": ["Linodes", "GPU Linodes"]}`))
+		case "/v4/linode/types/g1-gpu-rtx6000-1":
+			rw.Write([]byte(`{"id": "g1-gpu-rtx6000-1", "class": "gpu"}`))
+		case "/v4/linode/instances/123/migrate":
+			migrated = true
+			rw.Write([]byte(`{}`))
+		default:
+			rw.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	client := NewClient(nil)
+	client.SetBaseURL(ts.URL)
+
+	err := client.MigrateInstance(context.Background(), 123, InstanceMigrateOptions{Type: WarmMigration, Region: "us-west"})
+	if err == nil || migrated {
+		t.Fatalf("expected the migration to a region without GPU Linodes to be rejected, got %v", err)
+	}
+
+	err = client.MigrateInstance(context.Background(), 123, InstanceMigrateOptions{Type: WarmMigration, Region: "us-southeast"})
+	if err != nil || !migrated {
+		t.Fatalf("expected the migration to be issued, got %v", err)
+	}
+}
+
+func TestClient_BootInstanceAndWait(t *testing.T) {
+	booted := false
+
+	ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Set("Content-Type", "application/json")
+
+		switch r.Method + " " + r.URL.Path {
+		case "GET /v4/linode/instances/123/configs":
+			rw.Write([]byte(`{"data": [{"id": 1, "label": "default"}, {"id": 2, "label": "rescue"}], "page": 1, "pages": 1, "results": 2}`))
+		case "POST /v4/linode/instances/123/boot":
+			body, _ := io.ReadAll(r.Body)
+			if string(body) != `{"config_id":2}` {
+				t.Errorf("unexpected body %s", body)
+			}
+
+			booted = true
+			rw.Write([]byte(`{}`))
+		case "GET /v4/account/events":
+			rw.Write([]byte(`{"data": [{"id": 1, "action": "linode_boot", "status": "finished"}], "page": 1, "pages": 1, "results": 1}`))
+		case "GET /v4/linode/instances/123":
+			rw.Write([]byte(`{"id": 123, "status": "running"}`))
+		default:
+			rw.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	client := NewClient(nil)
+	client.SetBaseURL(ts.URL)
+	client.SetPollDelay(time.Millisecond)
+
+	if _, err := client.BootInstanceAndWait(context.Background(), 123, 3, 5); err == nil || booted {
+		t.Errorf("expected an error without booting for a config of another instance, got %v", err)
+	}
+
+	instance, err := client.BootInstanceAndWait(context.Background(), 123, 2, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !booted || instance.Status != InstanceRunning {
+		t.Errorf("expected the instance to be booted and running, got %+v", instance)
+	}
+}
@@ -0,0 +1,82 @@
+package linodego
+
+import (
+	"encoding/json"
+)
+
+// JSONMarshaler encodes request bodies, see SetJSONMarshaler.
+// The configs of jsoniter and sonic, e.g. jsoniter.ConfigCompatibleWithStandardLibrary, implement it.
+type JSONMarshaler interface {
+	Marshal(v any) ([]byte, error)
+}
+
+// JSONUnmarshaler decodes response bodies, see SetJSONUnmarshaler.
+// The configs of jsoniter and sonic, e.g. sonic.ConfigStd, implement it.
+type JSONUnmarshaler interface {
+	Unmarshal(data []byte, v any) error
+}
+
+// stdJSON implements JSONMarshaler and JSONUnmarshaler with encoding/json
+type stdJSON struct{}
+
+func (stdJSON) Marshal(v any) ([]byte, error) {
+	return json.Marshal(v)
+}
+
+func (stdJSON) Unmarshal(data []byte, v any) error {
+	return json.Unmarshal(data, v)
+}
+
+// SetJSONMarshaler sets the JSONMarshaler used to encode the bodies of requests,
+// defaulting to encoding/json. Types implementing json.Marshaler are still encoded by
+// their MarshalJSON method, which uses encoding/json.
+func (c *Client) SetJSONMarshaler(marshaler JSONMarshaler) *Client {
+	if marshaler == nil {
+		marshaler = stdJSON{}
+	}
+
+	c.jsonMarshaler = marshaler
+	c.resty.SetJSONMarshaler(marshaler.Marshal)
+
+	return c
+}
+
+// SetJSONUnmarshaler sets the JSONUnmarshaler used to decode the bodies of responses,
+// defaulting to encoding/json. Responses are decoded once and the decoded values are
+// cached, see UseCache. Types implementing json.Unmarshaler, e.g. those with timestamps,
+// are still decoded by their UnmarshalJSON method, which uses encoding/json.
+func (c *Client) SetJSONUnmarshaler(unmarshaler JSONUnmarshaler) *Client {
+	if unmarshaler == nil {
+		unmarshaler = stdJSON{}
+	}
+
+	c.jsonUnmarshaler = unmarshaler
+	c.updateJSONUnmarshaler()
+
+	return c
+}
+
+// updateJSONUnmarshaler sets the unmarshaler of responses from the JSONUnmarshaler and
+// whether strict decoding is enabled
+func (c *Client) updateJSONUnmarshaler() {
+	var unmarshaler JSONUnmarshaler = stdJSON{}
+	if c.jsonUnmarshaler != nil {
+		unmarshaler = c.jsonUnmarshaler
+	}
+
+	unmarshal := unmarshaler.Unmarshal
+	if c.strictDecoding {
+		unmarshal = strictJSONUnmarshal(unmarshal)
+	}
+
+	c.resty.SetJSONUnmarshaler(unmarshal)
+}
+
+// marshalJSON encodes v with the JSONMarshaler of the Client
+func (c *Client) marshalJSON(v any) ([]byte, error) {
+	if c.jsonMarshaler == nil {
+		return json.Marshal(v)
+	}
+
+	return c.jsonMarshaler.Marshal(v)
+}
@@ -0,0 +1,83 @@
+package linodego
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+type countingJSON struct {
+	marshaled, unmarshaled int
+}
+
+func (j *countingJSON) Marshal(v any) ([]byte, error) {
+	j.marshaled++
+	return json.Marshal(v)
+}
+
+func (j *countingJSON) Unmarshal(data []byte, v any) error {
+	j.unmarshaled++
+	return json.Unmarshal(data, v)
+}
+
+func TestClient_SetJSONMarshaler(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Set("Content-Type", "application/json")
+
+		switch r.Method + " " + r.URL.Path {
+		case "POST /v4/tags":
+			body, _ := io.ReadAll(r.Body)
+			if string(body) != `{"label":"prod"}` {
+				t.Errorf("unexpected body %s", body)
+			}
+
+			rw.Write([]byte(`{"label": "prod"}`))
+		case "GET /v4/linode/types/g6-nanode-1":
+			rw.Write([]byte(`{"id": "g6-nanode-1", "new_field": true}`))
+		default:
+			rw.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	codec := &countingJSON{}
+
+	client := NewClient(nil)
+	client.SetBaseURL(ts.URL)
+	client.SetJSONMarshaler(codec).SetJSONUnmarshaler(codec)
+	client.UseCache(true)
+
+	tag, err := client.CreateTag(context.Background(), TagCreateOptions{Label: "prod"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if tag.Label != "prod" || codec.marshaled != 1 || codec.unmarshaled != 1 {
+		t.Errorf("expected the request and response to use the custom serializer, got %+v", codec)
+	}
+
+	for i := 0; i < 2; i++ {
+		if _, err := client.GetType(context.Background(), "g6-nanode-1"); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if codec.unmarshaled != 2 {
+		t.Errorf("expected the cached response to be decoded once, got %d", codec.unmarshaled)
+	}
+
+	client.InvalidateCache()
+	client.SetStrictDecoding(true)
+
+	if _, err := client.GetType(context.Background(), "g6-nanode-1"); err == nil || !strings.Contains(err.Error(), "new_field") {
+		t.Errorf("expected an unknown field error, got %v", err)
+	}
+
+	if codec.unmarshaled != 3 {
+		t.Errorf("expected strict decoding to use the custom serializer, got %d", codec.unmarshaled)
+	}
+}
@@ -64,7 +64,7 @@ func (resp *LinodeKernelsPagedResponse) castResult(r *resty.Request, e string) (
 	return castedRes.Pages, castedRes.Results, nil
 }
 
-// ListKernels lists linode kernels. This endpoint is cached by default.
+// ListKernels lists linode kernels. This endpoint is cached when response caching is enabled.
 func (c *Client) ListKernels(ctx context.Context, opts *ListOptions) ([]LinodeKernel, error) {
 	response := LinodeKernelsPagedResponse{}
 
@@ -87,7 +87,7 @@ func (c *Client) ListKernels(ctx context.Context, opts *ListOptions) ([]LinodeKe
 	return response.Data, nil
 }
 
-// GetKernel gets the kernel with the provided ID. This endpoint is cached by default.
+// GetKernel gets the kernel with the provided ID. This endpoint is cached when response caching is enabled.
 func (c *Client) GetKernel(ctx context.Context, kernelID string) (*LinodeKernel, error) {
 	kernelID = url.PathEscape(kernelID)
 	e := fmt.Sprintf("linode/kernels/%s", kernelID)
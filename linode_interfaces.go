@@ -0,0 +1,418 @@
+package linodego
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/linode/linodego/internal/parseabletime"
+)
+
+// InterfaceGeneration is the networking model of an Instance
+type InterfaceGeneration string
+
+// InterfaceGeneration constants are the networking models of an Instance
+const (
+	// InterfaceGenerationLegacyConfig configures interfaces through the configuration profiles of the Instance
+	InterfaceGenerationLegacyConfig InterfaceGeneration = "legacy_config"
+
+	// InterfaceGenerationLinode configures interfaces on the Instance, see LinodeInterface
+	InterfaceGenerationLinode InterfaceGeneration = "linode"
+)
+
+// LinodeInterface is a network interface of an Instance using the Linode Interfaces networking model.
+// Exactly one of Public, VPC and VLAN is set.
+type LinodeInterface struct {
+	ID           int                          `json:"id"`
+	MACAddress   string                       `json:"mac_address"`
+	Version      int                          `json:"version"`
+	DefaultRoute *LinodeInterfaceDefaultRoute `json:"default_route"`
+	Public       *LinodeInterfacePublic       `json:"public"`
+	VPC          *LinodeInterfaceVPC          `json:"vpc"`
+	VLAN         *LinodeInterfaceVLAN         `json:"vlan"`
+	Created      *time.Time                   `json:"-"`
+	Updated      *time.Time                   `json:"-"`
+}
+
+// LinodeInterfaceDefaultRoute specifies whether the interface is the default route of the Instance
+type LinodeInterfaceDefaultRoute struct {
+	IPv4 *bool `json:"ipv4,omitempty"`
+	IPv6 *bool `json:"ipv6,omitempty"`
+}
+
+// LinodeInterfacePublic is the configuration of a public interface
+type LinodeInterfacePublic struct {
+	IPv4 *LinodeInterfacePublicIPv4 `json:"ipv4"`
+	IPv6 *LinodeInterfacePublicIPv6 `json:"ipv6"`
+}
+
+// LinodeInterfacePublicIPv4 are the IPv4 addresses of a public interface
+type LinodeInterfacePublicIPv4 struct {
+	Addresses []LinodeInterfacePublicIPv4Address `json:"addresses"`
+	Shared    []LinodeInterfaceSharedIPv4        `json:"shared"`
+}
+
+// LinodeInterfacePublicIPv4Address is an IPv4 address of a public interface
+type LinodeInterfacePublicIPv4Address struct {
+	Address string `json:"address"`
+	Primary bool   `json:"primary"`
+}
+
+// LinodeInterfaceSharedIPv4 is an IPv4 address shared with the public interface by another Instance
+type LinodeInterfaceSharedIPv4 struct {
+	Address  string `json:"address"`
+	LinodeID int    `json:"linode_id"`
+}
+
+// LinodeInterfacePublicIPv6 are the IPv6 addresses and ranges of a public interface
+type LinodeInterfacePublicIPv6 struct {
+	SLAAC  []LinodeInterfaceSLAAC     `json:"slaac"`
+	Shared []LinodeInterfaceIPv6Range `json:"shared"`
+	Ranges []LinodeInterfaceIPv6Range `json:"ranges"`
+}
+
+// LinodeInterfaceSLAAC is an IPv6 SLAAC address of a public interface
+type LinodeInterfaceSLAAC struct {
+	Address string `json:"address"`
+	Prefix  int    `json:"prefix"`
+}
+
+// LinodeInterfaceIPv6Range is an IPv6 range routed to a public interface
+type LinodeInterfaceIPv6Range struct {
+	Range       string  `json:"range"`
+	RouteTarget *string `json:"route_target"`
+}
+
+// LinodeInterfaceVPC is the configuration of a VPC interface
+type LinodeInterfaceVPC struct {
+	VPCID    int                     `json:"vpc_id"`
+	SubnetID int                     `json:"subnet_id"`
+	IPv4     *LinodeInterfaceVPCIPv4 `json:"ipv4"`
+}
+
+// LinodeInterfaceVPCIPv4 are the IPv4 addresses and ranges of a VPC interface
+type LinodeInterfaceVPCIPv4 struct {
+	Addresses []LinodeInterfaceVPCIPv4Address `json:"addresses"`
+	Ranges    []LinodeInterfaceVPCIPv4Range   `json:"ranges"`
+}
+
+// LinodeInterfaceVPCIPv4Address is an IPv4 address of a VPC interface.
+// NAT1To1Address is the public IPv4 address mapped to the address using 1:1 NAT, if any.
+type LinodeInterfaceVPCIPv4Address struct {
+	Address        string  `json:"address"`
+	Primary        bool    `json:"primary"`
+	NAT1To1Address *string `json:"nat_1_1_address"`
+}
+
+// LinodeInterfaceVPCIPv4Range is an IPv4 range routed to a VPC interface
+type LinodeInterfaceVPCIPv4Range struct {
+	Range string `json:"range"`
+}
+
+// LinodeInterfaceVLAN is the configuration of a VLAN interface
+type LinodeInterfaceVLAN struct {
+	VLANLabel   string  `json:"vlan_label"`
+	IPAMAddress *string `json:"ipam_address"`
+}
+
+// LinodeInterfaceCreateOptions fields are those accepted by CreateInterface.
+// Exactly one of Public, VPC and VLAN must be set.
+type LinodeInterfaceCreateOptions struct {
+	FirewallID   *int                          `json:"firewall_id,omitempty"`
+	DefaultRoute *LinodeInterfaceDefaultRoute  `json:"default_route,omitempty"`
+	Public       *LinodeInterfacePublicOptions `json:"public,omitempty"`
+	VPC          *LinodeInterfaceVPCOptions    `json:"vpc,omitempty"`
+	VLAN         *LinodeInterfaceVLANOptions   `json:"vlan,omitempty"`
+}
+
+// LinodeInterfaceUpdateOptions fields are those accepted by UpdateInterface.
+// The type of an interface and the VLAN of a VLAN interface cannot be updated.
+type LinodeInterfaceUpdateOptions struct {
+	DefaultRoute *LinodeInterfaceDefaultRoute  `json:"default_route,omitempty"`
+	Public       *LinodeInterfacePublicOptions `json:"public,omitempty"`
+	VPC          *LinodeInterfaceVPCOptions    `json:"vpc,omitempty"`
+}
+
+// LinodeInterfacePublicOptions are the options of a public interface
+type LinodeInterfacePublicOptions struct {
+	IPv4 *LinodeInterfacePublicIPv4Options `json:"ipv4,omitempty"`
+	IPv6 *LinodeInterfacePublicIPv6Options `json:"ipv6,omitempty"`
+}
+
+// LinodeInterfacePublicIPv4Options are the IPv4 addresses of a public interface.
+// An Address of "auto" assigns a new address.
+type LinodeInterfacePublicIPv4Options struct {
+	Addresses []LinodeInterfacePublicIPv4AddressOptions `json:"addresses,omitempty"`
+}
+
+// LinodeInterfacePublicIPv4AddressOptions is an IPv4 address of a public interface
+type LinodeInterfacePublicIPv4AddressOptions struct {
+	Address string `json:"address"`
+	Primary *bool  `json:"primary,omitempty"`
+}
+
+// LinodeInterfacePublicIPv6Options are the IPv6 ranges of a public interface
+type LinodeInterfacePublicIPv6Options struct {
+	Ranges []LinodeInterfaceIPv6RangeOptions `json:"ranges,omitempty"`
+}
+
+// LinodeInterfaceIPv6RangeOptions is an IPv6 range, e.g. "/64" to assign a new range of the given size
+type LinodeInterfaceIPv6RangeOptions struct {
+	Range string `json:"range"`
+}
+
+// LinodeInterfaceVPCOptions are the options of a VPC interface.
+// SubnetID is required when creating the interface and cannot be updated.
+type LinodeInterfaceVPCOptions struct {
+	SubnetID int                            `json:"subnet_id,omitempty"`
+	IPv4     *LinodeInterfaceVPCIPv4Options `json:"ipv4,omitempty"`
+}
+
+// LinodeInterfaceVPCIPv4Options are the IPv4 addresses and ranges of a VPC interface
+type LinodeInterfaceVPCIPv4Options struct {
+	Addresses []LinodeInterfaceVPCIPv4AddressOptions `json:"addresses,omitempty"`
+	Ranges    []LinodeInterfaceVPCIPv4RangeOptions   `json:"ranges,omitempty"`
+}
+
+// LinodeInterfaceVPCIPv4AddressOptions is an IPv4 address of a VPC interface.
+// An Address or NAT1To1Address of "auto" assigns a new address.
+type LinodeInterfaceVPCIPv4AddressOptions struct {
+	Address        string  `json:"address"`
+	Primary        *bool   `json:"primary,omitempty"`
+	NAT1To1Address *string `json:"nat_1_1_address,omitempty"`
+}
+
+// LinodeInterfaceVPCIPv4RangeOptions is an IPv4 range of a VPC interface, e.g. "/32" to assign a new range
+type LinodeInterfaceVPCIPv4RangeOptions struct {
+	Range string `json:"range"`
+}
+
+// LinodeInterfaceVLANOptions are the options of a VLAN interface
+type LinodeInterfaceVLANOptions struct {
+	VLANLabel   string  `json:"vlan_label"`
+	IPAMAddress *string `json:"ipam_address,omitempty"`
+}
+
+// LinodeInterfaceSettings are the networking settings of an Instance using Linode Interfaces
+type LinodeInterfaceSettings struct {
+	NetworkHelper bool                                `json:"network_helper"`
+	DefaultRoute  LinodeInterfaceSettingsDefaultRoute `json:"default_route"`
+}
+
+// LinodeInterfaceSettingsDefaultRoute are the interfaces that are, or are eligible to be, the default routes
+type LinodeInterfaceSettingsDefaultRoute struct {
+	IPv4InterfaceID          *int  `json:"ipv4_interface_id"`
+	IPv4EligibleInterfaceIDs []int `json:"ipv4_eligible_interface_ids"`
+	IPv6InterfaceID          *int  `json:"ipv6_interface_id"`
+	IPv6EligibleInterfaceIDs []int `json:"ipv6_eligible_interface_ids"`
+}
+
+// LinodeInterfaceSettingsUpdateOptions fields are those accepted by UpdateInterfaceSettings
+type LinodeInterfaceSettingsUpdateOptions struct {
+	NetworkHelper *bool                                             `json:"network_helper,omitempty"`
+	DefaultRoute  *LinodeInterfaceSettingsDefaultRouteUpdateOptions `json:"default_route,omitempty"`
+}
+
+// LinodeInterfaceSettingsDefaultRouteUpdateOptions are the interfaces to use as the default routes
+type LinodeInterfaceSettingsDefaultRouteUpdateOptions struct {
+	IPv4InterfaceID *int `json:"ipv4_interface_id,omitempty"`
+	IPv6InterfaceID *int `json:"ipv6_interface_id,omitempty"`
+}
+
+// linodeInterfacesResponse is the unpaginated response of ListInterfaces
+type linodeInterfacesResponse struct {
+	Interfaces []LinodeInterface `json:"interfaces"`
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (i *LinodeInterface) UnmarshalJSON(b []byte) error {
+	type Mask LinodeInterface
+
+	p := struct {
+		*Mask
+		Created *parseabletime.ParseableTime `json:"created"`
+		Updated *parseabletime.ParseableTime `json:"updated"`
+	}{
+		Mask: (*Mask)(i),
+	}
+
+	if err := json.Unmarshal(b, &p); err != nil {
+		return err
+	}
+
+	i.Created = (*time.Time)(p.Created)
+	i.Updated = (*time.Time)(p.Updated)
+
+	return nil
+}
+
+// Validate checks that exactly one of Public, VPC and VLAN is set, that a VPC interface has a subnet,
+// and that a VLAN interface, which cannot be a default route, is not set as one.
+func (opts LinodeInterfaceCreateOptions) Validate() error {
+	types := 0
+
+	for _, set := range []bool{opts.Public != nil, opts.VPC != nil, opts.VLAN != nil} {
+		if set {
+			types++
+		}
+	}
+
+	if types != 1 {
+		return errors.New("exactly one of public, vpc and vlan must be set for a Linode interface")
+	}
+
+	if opts.VPC != nil && opts.VPC.SubnetID == 0 {
+		return errors.New("subnet_id is required for a VPC Linode interface")
+	}
+
+	if opts.VLAN != nil {
+		if opts.VLAN.VLANLabel == "" {
+			return errors.New("vlan_label is required for a VLAN Linode interface")
+		}
+
+		if opts.DefaultRoute != nil && (isTrue(opts.DefaultRoute.IPv4) || isTrue(opts.DefaultRoute.IPv6)) {
+			return errors.New("a VLAN Linode interface cannot be a default route")
+		}
+	}
+
+	return nil
+}
+
+// validateLinodeInterfaces validates the interfaces of a new Instance and checks
+// that at most one of them is the default IPv4 route and one the default IPv6 route.
+func validateLinodeInterfaces(interfaces []LinodeInterfaceCreateOptions) error {
+	defaultIPv4Routes, defaultIPv6Routes := 0, 0
+
+	for i, opts := range interfaces {
+		if err := opts.Validate(); err != nil {
+			return fmt.Errorf("invalid Linode interface %d: %w", i, err)
+		}
+
+		if opts.DefaultRoute != nil {
+			if isTrue(opts.DefaultRoute.IPv4) {
+				defaultIPv4Routes++
+			}
+
+			if isTrue(opts.DefaultRoute.IPv6) {
+				defaultIPv6Routes++
+			}
+		}
+	}
+
+	if defaultIPv4Routes > 1 {
+		return fmt.Errorf("only one Linode interface can be the default IPv4 route, got %d", defaultIPv4Routes)
+	}
+
+	if defaultIPv6Routes > 1 {
+		return fmt.Errorf("only one Linode interface can be the default IPv6 route, got %d", defaultIPv6Routes)
+	}
+
+	return nil
+}
+
+func isTrue(value *bool) bool {
+	return value != nil && *value
+}
+
+// ListInterfaces lists the Linode interfaces of an Instance
+func (c *Client) ListInterfaces(ctx context.Context, linodeID int) ([]LinodeInterface, error) {
+	e := fmt.Sprintf("linode/instances/%d/interfaces", linodeID)
+	req := c.R(ctx).SetResult(&linodeInterfacesResponse{})
+	r, err := coupleAPIErrors(req.Get(e))
+	if err != nil {
+		return nil, err
+	}
+
+	return r.Result().(*linodeInterfacesResponse).Interfaces, nil
+}
+
+// GetInterface gets the Linode interface with the provided ID
+func (c *Client) GetInterface(ctx context.Context, linodeID, interfaceID int) (*LinodeInterface, error) {
+	e := fmt.Sprintf("linode/instances/%d/interfaces/%d", linodeID, interfaceID)
+	req := c.R(ctx).SetResult(&LinodeInterface{})
+	r, err := coupleAPIErrors(req.Get(e))
+	if err != nil {
+		return nil, err
+	}
+
+	return r.Result().(*LinodeInterface), nil
+}
+
+// CreateInterface adds a Linode interface to an Instance.
+// Setting the interface as a default route moves the default route from any other interface.
+func (c *Client) CreateInterface(ctx context.Context, linodeID int, opts LinodeInterfaceCreateOptions) (*LinodeInterface, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+
+	body, err := c.marshalJSON(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	e := fmt.Sprintf("linode/instances/%d/interfaces", linodeID)
+	req := c.R(ctx).SetResult(&LinodeInterface{}).SetBody(string(body))
+	r, err := coupleAPIErrors(req.Post(e))
+	if err != nil {
+		return nil, err
+	}
+
+	return r.Result().(*LinodeInterface), nil
+}
+
+// UpdateInterface updates the Linode interface with the provided ID
+func (c *Client) UpdateInterface(ctx context.Context, linodeID, interfaceID int, opts LinodeInterfaceUpdateOptions) (*LinodeInterface, error) {
+	body, err := c.marshalJSON(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	e := fmt.Sprintf("linode/instances/%d/interfaces/%d", linodeID, interfaceID)
+	req := c.R(ctx).SetResult(&LinodeInterface{}).SetBody(string(body))
+	r, err := coupleAPIErrors(req.Put(e))
+	if err != nil {
+		return nil, err
+	}
+
+	return r.Result().(*LinodeInterface), nil
+}
+
+// DeleteInterface deletes the Linode interface with the provided ID
+func (c *Client) DeleteInterface(ctx context.Context, linodeID, interfaceID int) error {
+	e := fmt.Sprintf("linode/instances/%d/interfaces/%d", linodeID, interfaceID)
+	_, err := coupleAPIErrors(c.R(ctx).Delete(e))
+
+	return err
+}
+
+// GetInterfaceSettings gets the networking settings of an Instance using Linode interfaces
+func (c *Client) GetInterfaceSettings(ctx context.Context, linodeID int) (*LinodeInterfaceSettings, error) {
+	e := fmt.Sprintf("linode/instances/%d/interfaces/settings", linodeID)
+	req := c.R(ctx).SetResult(&LinodeInterfaceSettings{})
+	r, err := coupleAPIErrors(req.Get(e))
+	if err != nil {
+		return nil, err
+	}
+
+	return r.Result().(*LinodeInterfaceSettings), nil
+}
+
+// UpdateInterfaceSettings updates the networking settings of an Instance using Linode interfaces,
+// e.g. the interfaces used as the default IPv4 and IPv6 routes
+func (c *Client) UpdateInterfaceSettings(ctx context.Context, linodeID int, opts LinodeInterfaceSettingsUpdateOptions) (*LinodeInterfaceSettings, error) {
+	body, err := c.marshalJSON(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	e := fmt.Sprintf("linode/instances/%d/interfaces/settings", linodeID)
+	req := c.R(ctx).SetResult(&LinodeInterfaceSettings{}).SetBody(string(body))
+	r, err := coupleAPIErrors(req.Put(e))
+	if err != nil {
+		return nil, err
+	}
+
+	return r.Result().(*LinodeInterfaceSettings), nil
+}
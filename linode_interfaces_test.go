@@ -0,0 +1,142 @@
+package linodego
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestClient_LinodeInterfaces(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Set("Content-Type", "application/json")
+
+		switch r.Method + " " + r.URL.Path {
+		case "GET /v4/linode/instances/123/interfaces":
+			rw.Write([]byte(`{"interfaces": [
+				{"id": 1, "mac_address": "22:00:AB:CD:EF:01", "version": 1, "created": "2025-01-01T00:00:00",
+					"default_route": {"ipv4": true, "ipv6": true},
+					"public": {"ipv4": {"addresses": [{"address": "192.0.2.1", "primary": true}], "shared": []},
+						"ipv6": {"slaac": [{"address": "2600:3c03::1", "prefix": 64}], "shared": [], "ranges": []}},
+					"vpc": null, "vlan": null},
+				{"id": 2, "default_route": {}, "public": null, "vpc": null, "vlan": {"vlan_label": "backend", "ipam_address": "10.0.0.1/24"}}
+			]}`))
+		case "POST /v4/linode/instances/123/interfaces":
+			body, _ := io.ReadAll(r.Body)
+			if string(body) != `{"default_route":{"ipv4":true},"vpc":{"subnet_id":456,"ipv4":{"addresses":[{"address":"auto","nat_1_1_address":"auto"}]}}}` {
+				t.Errorf("unexpected body %s", body)
+			}
+
+			rw.Write([]byte(`{"id": 3, "vpc": {"vpc_id": 789, "subnet_id": 456, "ipv4": {"addresses": [{"address": "10.0.0.2", "primary": true, "nat_1_1_address": "192.0.2.2"}], "ranges": []}}}`))
+		case "PUT /v4/linode/instances/123/interfaces/settings":
+			rw.Write([]byte(`{"network_helper": true, "default_route": {"ipv4_interface_id": 3, "ipv4_eligible_interface_ids": [1, 3], "ipv6_interface_id": 1, "ipv6_eligible_interface_ids": [1]}}`))
+		case "DELETE /v4/linode/instances/123/interfaces/2":
+			rw.Write([]byte(`{}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			rw.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	client := NewClient(nil)
+	client.SetBaseURL(ts.URL)
+
+	interfaces, err := client.ListInterfaces(context.Background(), 123)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(interfaces) != 2 || interfaces[0].Public == nil || interfaces[0].Public.IPv4.Addresses[0].Address != "192.0.2.1" ||
+		interfaces[0].Created == nil || interfaces[1].VLAN == nil || interfaces[1].VLAN.VLANLabel != "backend" {
+		t.Errorf("unexpected interfaces %+v", interfaces)
+	}
+
+	enabled := true
+	auto := "auto"
+
+	vpcInterface, err := client.CreateInterface(context.Background(), 123, LinodeInterfaceCreateOptions{
+		DefaultRoute: &LinodeInterfaceDefaultRoute{IPv4: &enabled},
+		VPC: &LinodeInterfaceVPCOptions{
+			SubnetID: 456,
+			IPv4:     &LinodeInterfaceVPCIPv4Options{Addresses: []LinodeInterfaceVPCIPv4AddressOptions{{Address: "auto", NAT1To1Address: &auto}}},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if vpcInterface.VPC == nil || *vpcInterface.VPC.IPv4.Addresses[0].NAT1To1Address != "192.0.2.2" {
+		t.Errorf("unexpected interface %+v", vpcInterface)
+	}
+
+	interfaceID := 3
+
+	settings, err := client.UpdateInterfaceSettings(context.Background(), 123, LinodeInterfaceSettingsUpdateOptions{
+		DefaultRoute: &LinodeInterfaceSettingsDefaultRouteUpdateOptions{IPv4InterfaceID: &interfaceID},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if settings.DefaultRoute.IPv4InterfaceID == nil || *settings.DefaultRoute.IPv4InterfaceID != 3 || !settings.NetworkHelper {
+		t.Errorf("unexpected settings %+v", settings)
+	}
+
+	if err := client.DeleteInterface(context.Background(), 123, 2); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestLinodeInterfaceCreateOptions_Validate(t *testing.T) {
+	enabled := true
+
+	for _, opts := range []LinodeInterfaceCreateOptions{
+		{},
+		{Public: &LinodeInterfacePublicOptions{}, VLAN: &LinodeInterfaceVLANOptions{VLANLabel: "backend"}},
+		{VPC: &LinodeInterfaceVPCOptions{}},
+		{VLAN: &LinodeInterfaceVLANOptions{}},
+		{VLAN: &LinodeInterfaceVLANOptions{VLANLabel: "backend"}, DefaultRoute: &LinodeInterfaceDefaultRoute{IPv4: &enabled}},
+	} {
+		if err := opts.Validate(); err == nil {
+			t.Errorf("expected %+v to be invalid", opts)
+		}
+	}
+}
+
+func TestInstanceCreateOptions_LinodeInterfaces(t *testing.T) {
+	enabled := true
+	public := LinodeInterfaceCreateOptions{Public: &LinodeInterfacePublicOptions{}, DefaultRoute: &LinodeInterfaceDefaultRoute{IPv4: &enabled, IPv6: &enabled}}
+
+	opts := InstanceCreateOptions{
+		Region:           "us-east",
+		Type:             "g6-nanode-1",
+		LinodeInterfaces: []LinodeInterfaceCreateOptions{public, {VLAN: &LinodeInterfaceVLANOptions{VLANLabel: "backend"}}},
+	}
+
+	if err := opts.Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	body, err := json.Marshal(opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `"interfaces":[{"default_route":{"ipv4":true,"ipv6":true},"public":{}},{"vlan":{"vlan_label":"backend"}}]`
+	if !strings.Contains(string(body), expected) || !strings.Contains(string(body), `"interface_generation":"linode"`) {
+		t.Errorf("expected %s and the linode interface generation in %s", expected, body)
+	}
+
+	opts.LinodeInterfaces = append(opts.LinodeInterfaces, LinodeInterfaceCreateOptions{
+		VPC:          &LinodeInterfaceVPCOptions{SubnetID: 456},
+		DefaultRoute: &LinodeInterfaceDefaultRoute{IPv4: &enabled},
+	})
+
+	if err := opts.Validate(); err == nil || !strings.Contains(err.Error(), "default IPv4 route") {
+		t.Errorf("expected an error for multiple default IPv4 routes, got %v", err)
+	}
+}
@@ -69,6 +69,9 @@ type LKEClusterDashboard struct {
 // LKEClusterControlPlane fields contained within the `control_plane` attribute of an LKE cluster.
 type LKEClusterControlPlane struct {
 	HighAvailability bool `json:"high_availability"`
+
+	// ACL restricts access to the Kubernetes API server, see GetLKEClusterControlPlaneACL
+	ACL *LKEClusterControlPlaneACL `json:"acl,omitempty"`
 }
 
 // LKEVersion fields are those returned by GetLKEVersion
@@ -145,7 +148,7 @@ func (resp *LKEVersionsPagedResponse) castResult(r *resty.Request, e string) (in
 	return castedRes.Pages, castedRes.Results, nil
 }
 
-// ListLKEVersions lists the Kubernetes versions available through LKE. This endpoint is cached by default.
+// ListLKEVersions lists the Kubernetes versions available through LKE. This endpoint is cached when response caching is enabled.
 func (c *Client) ListLKEVersions(ctx context.Context, opts *ListOptions) ([]LKEVersion, error) {
 	response := LKEVersionsPagedResponse{}
 
@@ -168,7 +171,7 @@ func (c *Client) ListLKEVersions(ctx context.Context, opts *ListOptions) ([]LKEV
 	return response.Data, nil
 }
 
-// GetLKEVersion gets details about a specific LKE Version. This endpoint is cached by default.
+// GetLKEVersion gets details about a specific LKE Version. This endpoint is cached when response caching is enabled.
 func (c *Client) GetLKEVersion(ctx context.Context, version string) (*LKEVersion, error) {
 	version = url.PathEscape(version)
 	e := fmt.Sprintf("lke/versions/%s", version)
@@ -265,7 +268,11 @@ func (c *Client) GetLKECluster(ctx context.Context, clusterID int) (*LKECluster,
 
 // CreateLKECluster creates a LKECluster
 func (c *Client) CreateLKECluster(ctx context.Context, opts LKEClusterCreateOptions) (*LKECluster, error) {
-	body, err := json.Marshal(opts)
+	if err := validateLKEClusterControlPlane(opts.ControlPlane); err != nil {
+		return nil, err
+	}
+
+	body, err := c.marshalJSON(opts)
 	if err != nil {
 		return nil, err
 	}
@@ -281,7 +288,11 @@ func (c *Client) CreateLKECluster(ctx context.Context, opts LKEClusterCreateOpti
 
 // UpdateLKECluster updates the LKECluster with the specified id
 func (c *Client) UpdateLKECluster(ctx context.Context, clusterID int, opts LKEClusterUpdateOptions) (*LKECluster, error) {
-	body, err := json.Marshal(opts)
+	if err := validateLKEClusterControlPlane(opts.ControlPlane); err != nil {
+		return nil, err
+	}
+
+	body, err := c.marshalJSON(opts)
 	if err != nil {
 		return nil, err
 	}
@@ -333,7 +344,7 @@ func (c *Client) RecycleLKEClusterNodes(ctx context.Context, clusterID int) erro
 
 // RegenerateLKECluster regenerates the Kubeconfig file and/or the service account token for the specified LKE Cluster.
 func (c *Client) RegenerateLKECluster(ctx context.Context, clusterID int, opts LKEClusterRegenerateOptions) (*LKECluster, error) {
-	body, err := json.Marshal(opts)
+	body, err := c.marshalJSON(opts)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,108 @@
+package linodego
+
+import (
+	"context"
+	"fmt"
+	"net"
+)
+
+// LKEClusterControlPlaneACL describes the ACL restricting access to the Kubernetes API server of an LKE Cluster
+type LKEClusterControlPlaneACL struct {
+	Enabled   bool                                `json:"enabled"`
+	Addresses *LKEClusterControlPlaneACLAddresses `json:"addresses,omitempty"`
+}
+
+// LKEClusterControlPlaneACLAddresses are the addresses allowed to access the Kubernetes API server.
+// A nil list is omitted, while an empty list removes all allowed addresses of that family.
+type LKEClusterControlPlaneACLAddresses struct {
+	IPv4 *[]string `json:"ipv4,omitempty"`
+	IPv6 *[]string `json:"ipv6,omitempty"`
+}
+
+// LKEClusterControlPlaneACLResponse is the response of GetLKEClusterControlPlaneACL and UpdateLKEClusterControlPlaneACL
+type LKEClusterControlPlaneACLResponse struct {
+	ACL LKEClusterControlPlaneACL `json:"acl"`
+}
+
+// LKEClusterControlPlaneACLUpdateOptions fields are those accepted by UpdateLKEClusterControlPlaneACL
+type LKEClusterControlPlaneACLUpdateOptions struct {
+	ACL LKEClusterControlPlaneACL `json:"acl"`
+}
+
+// Validate checks that the allowed addresses are IP addresses or CIDRs of the correct family
+func (acl LKEClusterControlPlaneACL) Validate() error {
+	if acl.Addresses == nil {
+		return nil
+	}
+
+	if acl.Addresses.IPv4 != nil {
+		for _, address := range *acl.Addresses.IPv4 {
+			if ip := parseACLAddress(address); ip == nil || ip.To4() == nil {
+				return fmt.Errorf("invalid control plane ACL IPv4 address %q", address)
+			}
+		}
+	}
+
+	if acl.Addresses.IPv6 != nil {
+		for _, address := range *acl.Addresses.IPv6 {
+			if ip := parseACLAddress(address); ip == nil || ip.To4() != nil {
+				return fmt.Errorf("invalid control plane ACL IPv6 address %q", address)
+			}
+		}
+	}
+
+	return nil
+}
+
+// parseACLAddress parses an IP address or CIDR, returning nil if it is invalid.
+func parseACLAddress(address string) net.IP {
+	if ip, _, err := net.ParseCIDR(address); err == nil {
+		return ip
+	}
+
+	return net.ParseIP(address)
+}
+
+// validateLKEClusterControlPlane validates the ACL of controlPlane if it is set.
+func validateLKEClusterControlPlane(controlPlane *LKEClusterControlPlane) error {
+	if controlPlane == nil || controlPlane.ACL == nil {
+		return nil
+	}
+
+	return controlPlane.ACL.Validate()
+}
+
+// GetLKEClusterControlPlaneACL gets the ACL configuration for the given cluster's control plane.
+func (c *Client) GetLKEClusterControlPlaneACL(ctx context.Context, clusterID int) (*LKEClusterControlPlaneACLResponse, error) {
+	e := fmt.Sprintf("lke/clusters/%d/control_plane_acl", clusterID)
+	req := c.R(ctx).SetResult(&LKEClusterControlPlaneACLResponse{})
+	r, err := coupleAPIErrors(req.Get(e))
+	if err != nil {
+		return nil, err
+	}
+	return r.Result().(*LKEClusterControlPlaneACLResponse), nil
+}
+
+// UpdateLKEClusterControlPlaneACL updates the ACL configuration for the given cluster's control plane.
+func (c *Client) UpdateLKEClusterControlPlaneACL(
+	ctx context.Context,
+	clusterID int,
+	opts LKEClusterControlPlaneACLUpdateOptions,
+) (*LKEClusterControlPlaneACLResponse, error) {
+	if err := opts.ACL.Validate(); err != nil {
+		return nil, err
+	}
+
+	body, err := c.marshalJSON(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	e := fmt.Sprintf("lke/clusters/%d/control_plane_acl", clusterID)
+	req := c.R(ctx).SetResult(&LKEClusterControlPlaneACLResponse{}).SetBody(string(body))
+	r, err := coupleAPIErrors(req.Put(e))
+	if err != nil {
+		return nil, err
+	}
+	return r.Result().(*LKEClusterControlPlaneACLResponse), nil
+}
@@ -0,0 +1,64 @@
+package linodego
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+)
+
+func TestLKEClusterControlPlaneACL_Validate(t *testing.T) {
+	valid := LKEClusterControlPlaneACL{
+		Enabled: true,
+		Addresses: &LKEClusterControlPlaneACLAddresses{
+			IPv4: &[]string{"10.0.0.1", "192.168.0.0/24"},
+			IPv6: &[]string{"2001:db8::/32", "2001:db8::1"},
+		},
+	}
+
+	if err := valid.Validate(); err != nil {
+		t.Errorf("expected the ACL to be valid, got %v", err)
+	}
+
+	invalid := []LKEClusterControlPlaneACLAddresses{
+		{IPv4: &[]string{"10.0.0.0/33"}},
+		{IPv4: &[]string{"2001:db8::/32"}},
+		{IPv6: &[]string{"10.0.0.1"}},
+		{IPv6: &[]string{"not-an-address"}},
+	}
+
+	for _, addresses := range invalid {
+		addresses := addresses
+
+		acl := LKEClusterControlPlaneACL{Enabled: true, Addresses: &addresses}
+		if err := acl.Validate(); err == nil {
+			t.Errorf("expected %v to be invalid", addresses)
+		}
+	}
+
+	// Invalid addresses must be rejected before making a request
+	client := NewClient(nil)
+	client.SetBaseURL("http://127.0.0.1:0")
+
+	_, err := client.UpdateLKEClusterControlPlaneACL(context.Background(), 123, LKEClusterControlPlaneACLUpdateOptions{
+		ACL: LKEClusterControlPlaneACL{Addresses: &invalid[0]},
+	})
+	if err == nil || err.Error() != `invalid control plane ACL IPv4 address "10.0.0.0/33"` {
+		t.Errorf("expected a validation error, got %v", err)
+	}
+}
+
+func TestLKEClusterControlPlaneACL_MarshalJSON(t *testing.T) {
+	acl := LKEClusterControlPlaneACL{
+		Enabled:   true,
+		Addresses: &LKEClusterControlPlaneACLAddresses{IPv4: &[]string{}},
+	}
+
+	body, err := json.Marshal(acl)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if expected := `{"enabled":true,"addresses":{"ipv4":[]}}`; string(body) != expected {
+		t.Errorf("expected %s, got %s", expected, body)
+	}
+}
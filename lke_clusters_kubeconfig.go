@@ -0,0 +1,157 @@
+package linodego
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// LKEClusterKubeconfigParsed is a decoded LKEClusterKubeconfig with the
+// details of its current context extracted
+type LKEClusterKubeconfigParsed struct {
+	// Server is the URL of the Kubernetes API server
+	Server string
+
+	// CertificateAuthorityData is the PEM encoded CA certificate of the API server
+	CertificateAuthorityData []byte
+
+	// Token is the bearer token used to authenticate with the API server
+	Token string
+
+	// Raw is the decoded kubeconfig YAML
+	Raw []byte
+}
+
+type kubeconfigFile struct {
+	CurrentContext string `yaml:"current-context"`
+	Clusters       []struct {
+		Name    string `yaml:"name"`
+		Cluster struct {
+			Server                   string `yaml:"server"`
+			CertificateAuthorityData string `yaml:"certificate-authority-data"`
+		} `yaml:"cluster"`
+	} `yaml:"clusters"`
+	Users []struct {
+		Name string `yaml:"name"`
+		User struct {
+			Token string `yaml:"token"`
+		} `yaml:"user"`
+	} `yaml:"users"`
+	Contexts []struct {
+		Name    string `yaml:"name"`
+		Context struct {
+			Cluster string `yaml:"cluster"`
+			User    string `yaml:"user"`
+		} `yaml:"context"`
+	} `yaml:"contexts"`
+}
+
+// Parse decodes the base64 encoded kubeconfig and extracts the server, CA data and
+// token of its current context, or of its first cluster and user if no context is selected.
+func (k LKEClusterKubeconfig) Parse() (*LKEClusterKubeconfigParsed, error) {
+	raw, err := base64.StdEncoding.DecodeString(k.KubeConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode kubeconfig: %w", err)
+	}
+
+	var file kubeconfigFile
+	if err := yaml.Unmarshal(raw, &file); err != nil {
+		return nil, fmt.Errorf("failed to parse kubeconfig: %w", err)
+	}
+
+	if len(file.Clusters) == 0 || len(file.Users) == 0 {
+		return nil, fmt.Errorf("kubeconfig does not contain a cluster and user")
+	}
+
+	clusterName, userName := file.Clusters[0].Name, file.Users[0].Name
+
+	for _, c := range file.Contexts {
+		if c.Name == file.CurrentContext {
+			clusterName, userName = c.Context.Cluster, c.Context.User
+			break
+		}
+	}
+
+	parsed := &LKEClusterKubeconfigParsed{Raw: raw}
+
+	for _, c := range file.Clusters {
+		if c.Name != clusterName {
+			continue
+		}
+
+		parsed.Server = c.Cluster.Server
+
+		if parsed.CertificateAuthorityData, err = base64.StdEncoding.DecodeString(c.Cluster.CertificateAuthorityData); err != nil {
+			return nil, fmt.Errorf("failed to decode certificate authority data: %w", err)
+		}
+	}
+
+	for _, u := range file.Users {
+		if u.Name == userName {
+			parsed.Token = u.User.Token
+		}
+	}
+
+	if parsed.Server == "" {
+		return nil, fmt.Errorf("kubeconfig does not contain a server for cluster %q", clusterName)
+	}
+
+	return parsed, nil
+}
+
+// TokenExpiry returns the expiry of the token if it is a JWT with an exp claim.
+// The boolean is false if the token does not encode an expiry.
+func (k LKEClusterKubeconfigParsed) TokenExpiry() (time.Time, bool) {
+	return jwtExpiry(k.Token)
+}
+
+// jwtExpiry returns the exp claim of the token if it is a JWT, without verifying it
+func jwtExpiry(token string) (time.Time, bool) {
+	segments := strings.Split(token, ".")
+	if len(segments) != 3 {
+		return time.Time{}, false
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(segments[1], "="))
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	var claims struct {
+		Exp *json.Number `json:"exp"`
+	}
+
+	if err := json.Unmarshal(payload, &claims); err != nil || claims.Exp == nil {
+		return time.Time{}, false
+	}
+
+	exp, err := claims.Exp.Float64()
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	return time.Unix(int64(exp), 0), true
+}
+
+// GetLKEClusterKubeconfigParsed gets the Kubeconfig for the LKE Cluster specified and parses it
+func (c *Client) GetLKEClusterKubeconfigParsed(ctx context.Context, clusterID int) (*LKEClusterKubeconfigParsed, error) {
+	kubeconfig, err := c.GetLKEClusterKubeconfig(ctx, clusterID)
+	if err != nil {
+		return nil, err
+	}
+
+	return kubeconfig.Parse()
+}
+
+// RegenerateLKEClusterKubeconfig deletes the Kubeconfig of the LKE Cluster specified, revoking its token.
+// A new Kubeconfig is generated and is returned by GetLKEClusterKubeconfig once it is available.
+func (c *Client) RegenerateLKEClusterKubeconfig(ctx context.Context, clusterID int) error {
+	e := fmt.Sprintf("lke/clusters/%d/kubeconfig", clusterID)
+	_, err := coupleAPIErrors(c.R(ctx).Delete(e))
+	return err
+}
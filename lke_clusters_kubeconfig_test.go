@@ -0,0 +1,66 @@
+package linodego
+
+import (
+	"encoding/base64"
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestLKEClusterKubeconfig_Parse(t *testing.T) {
+	claims := base64.RawURLEncoding.EncodeToString([]byte(`{"sub": "lke-admin", "exp": 1700000000}`))
+	token := "eyJhbGciOiJSUzI1NiJ9." + claims + ".c2lnbmF0dXJl"
+	ca := base64.StdEncoding.EncodeToString([]byte("-----BEGIN CERTIFICATE-----"))
+
+	kubeconfig := fmt.Sprintf(`apiVersion: v1
+kind: Config
+clusters:
+- name: other
+  cluster:
+    server: https://other.example.com:443
+- name: lke123
+  cluster:
+    certificate-authority-data: %s
+    server: https://123.us-east.linodelke.net:443
+users:
+- name: lke123-admin
+  user:
+    token: %s
+contexts:
+- name: lke123-ctx
+  context:
+    cluster: lke123
+    user: lke123-admin
+current-context: lke123-ctx
+`, ca, token)
+
+	parsed, err := LKEClusterKubeconfig{KubeConfig: base64.StdEncoding.EncodeToString([]byte(kubeconfig))}.Parse()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if parsed.Server != "https://123.us-east.linodelke.net:443" {
+		t.Errorf("unexpected server %q", parsed.Server)
+	}
+
+	if string(parsed.CertificateAuthorityData) != "-----BEGIN CERTIFICATE-----" {
+		t.Errorf("unexpected CA data %q", parsed.CertificateAuthorityData)
+	}
+
+	if parsed.Token != token {
+		t.Errorf("unexpected token %q", parsed.Token)
+	}
+
+	expiry, ok := parsed.TokenExpiry()
+	if !ok || !expiry.Equal(time.Unix(1700000000, 0)) {
+		t.Errorf("unexpected token expiry %v", expiry)
+	}
+
+	if _, ok := (LKEClusterKubeconfigParsed{Token: "opaque-token"}).TokenExpiry(); ok {
+		t.Error("expected no expiry for an opaque token")
+	}
+
+	if _, err := (LKEClusterKubeconfig{KubeConfig: "not base64!"}).Parse(); err == nil {
+		t.Error("expected an error for an invalid kubeconfig")
+	}
+}
@@ -0,0 +1,71 @@
+package linodego
+
+import (
+	"log"
+	"sync"
+)
+
+// stdLogger is the default Logger used by a Client.
+// It writes all output to the standard library logger.
+type stdLogger struct{}
+
+func (stdLogger) Errorf(format string, v ...any) {
+	log.Printf("[ERROR] "+format, v...)
+}
+
+func (stdLogger) Warnf(format string, v ...any) {
+	log.Printf("[WARN] "+format, v...)
+}
+
+func (stdLogger) Debugf(format string, v ...any) {
+	log.Printf("[DEBUG] "+format, v...)
+}
+
+// clientLogger forwards log output to the Logger configured with SetLogger.
+// It is shared between copies of a Client so that hooks registered on the
+// underlying resty client observe loggers set after the Client was created.
+// A nil Logger discards all output.
+type clientLogger struct {
+	mu     sync.RWMutex
+	logger Logger
+}
+
+func newClientLogger() *clientLogger {
+	return &clientLogger{logger: stdLogger{}}
+}
+
+func (l *clientLogger) setLogger(logger Logger) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.logger = logger
+}
+
+func (l *clientLogger) getLogger() Logger {
+	if l == nil {
+		return nil
+	}
+
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	return l.logger
+}
+
+func (l *clientLogger) Errorf(format string, v ...any) {
+	if logger := l.getLogger(); logger != nil {
+		logger.Errorf(format, v...)
+	}
+}
+
+func (l *clientLogger) Warnf(format string, v ...any) {
+	if logger := l.getLogger(); logger != nil {
+		logger.Warnf(format, v...)
+	}
+}
+
+func (l *clientLogger) Debugf(format string, v ...any) {
+	if logger := l.getLogger(); logger != nil {
+		logger.Debugf(format, v...)
+	}
+}
@@ -10,7 +10,11 @@ import (
 	"github.com/linode/linodego/internal/parseabletime"
 )
 
-// LongviewClient represents a LongviewClient object
+// longviewInstallScriptBaseURL is the base URL of the Longview agent install scripts
+const longviewInstallScriptBaseURL = "https://lv.linode.com/"
+
+// LongviewClient represents a LongviewClient object.
+// APIKey is redacted from the output of SetDebug.
 type LongviewClient struct {
 	ID          int        `json:"id"`
 	APIKey      string     `json:"api_key"`
@@ -25,6 +29,12 @@ type LongviewClient struct {
 	} `json:"apps"`
 }
 
+// InstallScriptURL returns the URL of the script that installs the Longview agent for the client,
+// e.g. `curl -s <url> | sudo bash`
+func (i LongviewClient) InstallScriptURL() string {
+	return longviewInstallScriptBaseURL + i.InstallCode
+}
+
 // LongviewClientCreateOptions is an options struct used when Creating a Longview Client
 type LongviewClientCreateOptions struct {
 	Label string `json:"label"`
@@ -85,7 +95,7 @@ func (c *Client) ListLongviewClients(ctx context.Context, opts *ListOptions) ([]
 // GetLongviewClient gets the template with the provided ID
 func (c *Client) GetLongviewClient(ctx context.Context, clientID int) (*LongviewClient, error) {
 	e := fmt.Sprintf("longview/clients/%d", clientID)
-	r, err := c.R(ctx).SetResult(&LongviewClient{}).Get(e)
+	r, err := coupleAPIErrors(c.R(ctx).SetResult(&LongviewClient{}).Get(e))
 	if err != nil {
 		return nil, err
 	}
@@ -94,7 +104,7 @@ func (c *Client) GetLongviewClient(ctx context.Context, clientID int) (*Longview
 
 // CreateLongviewClient creates a Longview Client
 func (c *Client) CreateLongviewClient(ctx context.Context, opts LongviewClientCreateOptions) (*LongviewClient, error) {
-	body, err := json.Marshal(opts)
+	body, err := c.marshalJSON(opts)
 	if err != nil {
 		return nil, err
 	}
@@ -117,7 +127,7 @@ func (c *Client) DeleteLongviewClient(ctx context.Context, clientID int) error {
 
 // UpdateLongviewClient updates a Longview Client
 func (c *Client) UpdateLongviewClient(ctx context.Context, clientID int, opts LongviewClientUpdateOptions) (*LongviewClient, error) {
-	body, err := json.Marshal(opts)
+	body, err := c.marshalJSON(opts)
 	if err != nil {
 		return nil, err
 	}
@@ -134,7 +144,7 @@ func (c *Client) UpdateLongviewClient(ctx context.Context, clientID int, opts Lo
 // GetLongviewPlan gets the template with the provided ID
 func (c *Client) GetLongviewPlan(ctx context.Context) (*LongviewPlan, error) {
 	e := "longview/plan"
-	r, err := c.R(ctx).SetResult(&LongviewPlan{}).Get(e)
+	r, err := coupleAPIErrors(c.R(ctx).SetResult(&LongviewPlan{}).Get(e))
 	if err != nil {
 		return nil, err
 	}
@@ -143,7 +153,7 @@ func (c *Client) GetLongviewPlan(ctx context.Context) (*LongviewPlan, error) {
 
 // UpdateLongviewPlan updates a Longview Plan
 func (c *Client) UpdateLongviewPlan(ctx context.Context, opts LongviewPlanUpdateOptions) (*LongviewPlan, error) {
-	body, err := json.Marshal(opts)
+	body, err := c.marshalJSON(opts)
 	if err != nil {
 		return nil, err
 	}
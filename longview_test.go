@@ -0,0 +1,46 @@
+package linodego
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestClient_CreateLongviewClient(t *testing.T) {
+	ts, client := createTestServer(http.MethodPost, "/v4/longview/clients", "application/json",
+		`{"id": 123, "label": "example", "api_key": "BD1B4B54-D752-A76D-5A9BD8A17F39DB61", "install_code": "BD1B5605-BF5E-D385-BA07AD518BE7F321"}`, http.StatusOK)
+	defer ts.Close()
+
+	logger := &testLogger{}
+	client.SetLogger(logger)
+	client.SetDebug(true)
+
+	lvClient, err := client.CreateLongviewClient(context.Background(), LongviewClientCreateOptions{Label: "example"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if lvClient.APIKey != "BD1B4B54-D752-A76D-5A9BD8A17F39DB61" {
+		t.Errorf("unexpected api key %q", lvClient.APIKey)
+	}
+
+	if url := lvClient.InstallScriptURL(); url != "https://lv.linode.com/BD1B5605-BF5E-D385-BA07AD518BE7F321" {
+		t.Errorf("unexpected install script url %q", url)
+	}
+
+	output := strings.Join(logger.messages, "\n")
+	if strings.Contains(output, lvClient.APIKey) {
+		t.Errorf("expected the api key to be redacted from the debug output:\n%s", output)
+	}
+}
+
+func TestClient_GetLongviewPlan_error(t *testing.T) {
+	ts, client := createTestServer(http.MethodGet, "/v4/longview/plan", "application/json",
+		`{"errors": [{"reason": "Unauthorized"}]}`, http.StatusUnauthorized)
+	defer ts.Close()
+
+	if _, err := client.GetLongviewPlan(context.Background()); err == nil {
+		t.Error("expected an error")
+	}
+}
@@ -0,0 +1,169 @@
+// Package metrics provides Prometheus metrics for linodego clients.
+package metrics
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/linode/linodego"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+type startTimeContextKey struct{}
+
+// staticPathSegments are the segments of the API endpoints used by linodego that are not
+// IDs or names, e.g. "instances" or "boot". Any other segment is labeled as "{id}".
+var staticPathSegments = map[string]bool{}
+
+func init() {
+	for _, segment := range strings.Fields(`
+		access account accounts api-endpoints assign attach availability backups betas boot buckets
+		cancel clients clone clusters configs control_plane_acl credentials dashboard dashboards
+		databases detach devices disks domains enable endpoints engines events firewalls grants groups
+		images instances interfaces invoices ips ipv6 items kernels keys kubeconfig linode lke logins
+		longview maintenance metric-definitions metrics migrate monitor mutate mysql networking
+		nodebalancers nodes notifications oauth-clients object-acl object-storage object-url order
+		password patch payments phone-number placement plan pool pools postgresql profile ranges read
+		reboot rebuild records recycle regenerate regions rescue reset resize restore security-questions
+		seen services servicetoken settings share shutdown sshkeys ssl stackscripts stats subnets
+		subscriptions support tags templates tfa-disable tfa-enable tfa-enable-confirm tickets token
+		tokens transfer types unassign upload users verify versions vlans volumes vpcs zone-file
+	`) {
+		staticPathSegments[segment] = true
+	}
+}
+
+type collectors struct {
+	requests      *prometheus.CounterVec
+	retries       *prometheus.CounterVec
+	rateLimitHits *prometheus.CounterVec
+	duration      *prometheus.HistogramVec
+}
+
+func newCollectors() collectors {
+	return collectors{
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "linodego_requests_total",
+			Help: "Total number of requests made to the Linode API by final response status class.",
+		}, []string{"endpoint", "status_class"}),
+		retries: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "linodego_retries_total",
+			Help: "Total number of retried requests to the Linode API by retry condition.",
+		}, []string{"endpoint", "reason"}),
+		rateLimitHits: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "linodego_rate_limit_hits_total",
+			Help: "Total number of responses from the Linode API indicating the request was rate limited.",
+		}, []string{"endpoint"}),
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "linodego_request_duration_seconds",
+			Help:    "Duration of requests to the Linode API, including all retry attempts.",
+			Buckets: prometheus.DefBuckets,
+		}, []string{"endpoint", "status_class"}),
+	}
+}
+
+// Register registers the linodego_requests_total, linodego_retries_total,
+// linodego_rate_limit_hits_total and linodego_request_duration_seconds metrics with
+// registerer and configures client to record them for every API request.
+// Endpoints are labeled by their path with IDs and names, e.g. of users or buckets, replaced by "{id}".
+func Register(client *linodego.Client, registerer prometheus.Registerer) error {
+	_, err := register(client, registerer)
+	return err
+}
+
+func register(client *linodego.Client, registerer prometheus.Registerer) (collectors, error) {
+	c := newCollectors()
+
+	for _, collector := range []prometheus.Collector{c.requests, c.retries, c.rateLimitHits, c.duration} {
+		if err := registerer.Register(collector); err != nil {
+			return c, fmt.Errorf("failed to register linodego metrics: %w", err)
+		}
+	}
+
+	client.OnBeforeRequest(func(request *linodego.Request) error {
+		if _, ok := request.Context().Value(startTimeContextKey{}).(time.Time); !ok {
+			request.SetContext(context.WithValue(request.Context(), startTimeContextKey{}, time.Now()))
+		}
+
+		return nil
+	})
+
+	client.OnAfterResponse(func(response *linodego.Response) error {
+		if response.StatusCode() == http.StatusTooManyRequests {
+			c.rateLimitHits.WithLabelValues(endpointLabel(response.Request.URL)).Inc()
+		}
+
+		return nil
+	})
+
+	client.OnRetry(func(response *linodego.Response, _ int, reason string) {
+		endpoint := "unknown"
+		if response != nil && response.Request != nil {
+			endpoint = endpointLabel(response.Request.URL)
+		}
+
+		c.retries.WithLabelValues(endpoint, reason).Inc()
+	})
+
+	client.OnRequestFinished(func(request *linodego.Request, response *linodego.Response, err error) {
+		endpoint := endpointLabel(request.URL)
+		class := statusClass(response, err)
+
+		c.requests.WithLabelValues(endpoint, class).Inc()
+
+		if start, ok := request.Context().Value(startTimeContextKey{}).(time.Time); ok {
+			c.duration.WithLabelValues(endpoint, class).Observe(time.Since(start).Seconds())
+		}
+	})
+
+	return c, nil
+}
+
+// endpointLabel returns a low-cardinality label for the given request URL. Segments that are not
+// known static segments are replaced by "{id}", collapsing consecutive ones such as the cluster
+// and name of a bucket or the "private/123" ID of an image, so the number of labels is bounded.
+func endpointLabel(requestURL string) string {
+	path := requestURL
+	if i := strings.IndexAny(path, "?#"); i >= 0 {
+		path = path[:i]
+	}
+
+	if i := strings.Index(path, "://"); i >= 0 {
+		// Strip the scheme, host and API version of absolute URLs
+		path = path[i+3:]
+
+		segments := strings.SplitN(path, "/", 3)
+		if len(segments) == 3 {
+			path = segments[2]
+		} else {
+			path = ""
+		}
+	}
+
+	var labels []string
+
+	for _, segment := range strings.Split(strings.Trim(path, "/"), "/") {
+		switch {
+		case segment == "":
+		case staticPathSegments[segment]:
+			labels = append(labels, segment)
+		case len(labels) == 0 || labels[len(labels)-1] != "{id}":
+			labels = append(labels, "{id}")
+		}
+	}
+
+	return strings.Join(labels, "/")
+}
+
+// statusClass returns the HTTP status class of the response, e.g. "2xx",
+// or "error" if no response was received.
+func statusClass(response *linodego.Response, err error) string {
+	if err != nil || response == nil || response.RawResponse == nil {
+		return "error"
+	}
+
+	return fmt.Sprintf("%dxx", response.StatusCode()/100)
+}
@@ -0,0 +1,94 @@
+package metrics
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/linode/linodego"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestRegister(t *testing.T) {
+	requests := 0
+
+	ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		requests++
+
+		rw.Header().Set("Content-Type", "application/json")
+
+		if requests == 1 {
+			rw.Header().Set("Retry-After", "0")
+			rw.WriteHeader(http.StatusTooManyRequests)
+			rw.Write([]byte(`{"errors": [{"reason": "Too many requests"}]}`))
+
+			return
+		}
+
+		rw.WriteHeader(http.StatusNotFound)
+		rw.Write([]byte(`{"errors": [{"reason": "Not found"}]}`))
+	}))
+	defer ts.Close()
+
+	client := linodego.NewClient(nil)
+	client.SetBaseURL(ts.URL)
+	client.SetRetryWaitTime(0)
+
+	registry := prometheus.NewRegistry()
+
+	c, err := register(&client, registry)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := client.GetInstance(context.Background(), 123); !linodego.IsNotFound(err) {
+		t.Fatalf("expected not found error, got %v", err)
+	}
+
+	const endpoint = "linode/instances/{id}"
+
+	if v := testutil.ToFloat64(c.requests.WithLabelValues(endpoint, "4xx")); v != 1 {
+		t.Errorf("expected 1 request to be recorded, got %v", v)
+	}
+
+	if v := testutil.ToFloat64(c.retries.WithLabelValues(endpoint, "tooManyRequests")); v != 1 {
+		t.Errorf("expected 1 retry to be recorded, got %v", v)
+	}
+
+	if v := testutil.ToFloat64(c.rateLimitHits.WithLabelValues(endpoint)); v != 1 {
+		t.Errorf("expected 1 rate limit hit to be recorded, got %v", v)
+	}
+
+	if n := testutil.CollectAndCount(c.duration); n != 1 {
+		t.Errorf("expected 1 request duration series, got %d", n)
+	}
+
+	if err := Register(&client, registry); err == nil {
+		t.Error("expected registering the metrics twice to fail")
+	}
+}
+
+func TestEndpointLabel(t *testing.T) {
+	for _, tc := range []struct {
+		url      string
+		expected string
+	}{
+		{"linode/instances/123", "linode/instances/{id}"},
+		{"https://api.linode.com/v4/linode/instances/123/disks/456?page=2", "linode/instances/{id}/disks/{id}"},
+		{"http://127.0.0.1:8080/v4beta/regions", "regions"},
+		{"/volumes", "volumes"},
+		{"account/users/jane.doe/grants", "account/users/{id}/grants"},
+		{"images/private%2F123", "images/{id}"},
+		{"images/private/123/regions", "images/{id}/regions"},
+		{"object-storage/buckets/us-east-1/my-bucket/object-acl", "object-storage/buckets/{id}/object-acl"},
+		{"tags/production", "tags/{id}"},
+		{"linode/types/g6-nanode-1", "linode/types/{id}"},
+		{"https://monitor-api.linode.com/v2/monitor/services/dbaas/metrics", "monitor/services/{id}/metrics"},
+	} {
+		if actual := endpointLabel(tc.url); actual != tc.expected {
+			t.Errorf("expected endpoint label for %q to be %q, got %q", tc.url, tc.expected, actual)
+		}
+	}
+}
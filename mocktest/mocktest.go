@@ -0,0 +1,174 @@
+// Package mocktest provides an http.RoundTripper that serves canned responses,
+// so that code using linodego can be unit tested without the Linode API:
+//
+//	mock := mocktest.New()
+//	mock.HandleJSON(http.MethodGet, "/linode/instances/123", http.StatusOK, map[string]any{"id": 123})
+//
+//	client := linodego.NewClientFromRoundTripper(mock)
+//	instance, err := client.GetInstance(ctx, 123)
+package mocktest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// apiVersionPrefix matches the API version at the start of request paths, e.g. /v4 or /v4beta
+var apiVersionPrefix = regexp.MustCompile(`^/v\d+[a-z]*`)
+
+// HandlerFunc returns the response to a matched request
+type HandlerFunc func(*http.Request) (*http.Response, error)
+
+// Request is a request received by a Transport
+type Request struct {
+	Method string
+
+	// Path is the path of the request without the API version, e.g. /linode/instances/123
+	Path string
+
+	Header http.Header
+	Body   []byte
+}
+
+type route struct {
+	method   string
+	segments []string
+	handler  HandlerFunc
+}
+
+// Transport is an http.RoundTripper that matches requests by method and path and
+// returns the response of the first matching handler. Requests that match no handler
+// receive a 404 response with a Linode API error body. It is safe for concurrent use.
+type Transport struct {
+	mu       sync.Mutex
+	routes   []route
+	requests []Request
+}
+
+var _ http.RoundTripper = (*Transport)(nil)
+
+// New returns a Transport without handlers
+func New() *Transport {
+	return &Transport{}
+}
+
+// HandleFunc registers a handler for requests with the given method and path. The path
+// excludes the API version, e.g. /linode/instances/123, and a segment of * matches any
+// single segment, e.g. /linode/instances/*/disks.
+func (t *Transport) HandleFunc(method, path string, handler HandlerFunc) *Transport {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.routes = append(t.routes, route{
+		method:   strings.ToUpper(method),
+		segments: splitPath(path),
+		handler:  handler,
+	})
+
+	return t
+}
+
+// Handle registers a response with the given status and body for requests with the given method and path
+func (t *Transport) Handle(method, path string, status int, body string) *Transport {
+	return t.HandleFunc(method, path, func(r *http.Request) (*http.Response, error) {
+		return Response(r, status, body), nil
+	})
+}
+
+// HandleJSON registers a response with the given status and v encoded as JSON
+// for requests with the given method and path
+func (t *Transport) HandleJSON(method, path string, status int, v any) *Transport {
+	body, err := json.Marshal(v)
+	if err != nil {
+		panic(fmt.Sprintf("mocktest: failed to encode response for %s %s: %s", method, path, err))
+	}
+
+	return t.Handle(method, path, status, string(body))
+}
+
+// Requests returns the requests received so far, in order
+func (t *Transport) Requests() []Request {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return append([]Request(nil), t.requests...)
+}
+
+// RoundTrip implements the http.RoundTripper interface
+func (t *Transport) RoundTrip(r *http.Request) (*http.Response, error) {
+	var body []byte
+
+	if r.Body != nil {
+		var err error
+		if body, err = io.ReadAll(r.Body); err != nil {
+			return nil, err
+		}
+
+		r.Body.Close()
+		r.Body = io.NopCloser(bytes.NewReader(body))
+	}
+
+	path := apiVersionPrefix.ReplaceAllString(r.URL.Path, "")
+
+	t.mu.Lock()
+
+	t.requests = append(t.requests, Request{Method: r.Method, Path: path, Header: r.Header.Clone(), Body: body})
+
+	var handler HandlerFunc
+
+	for _, route := range t.routes {
+		if route.method == r.Method && matchPath(route.segments, splitPath(path)) {
+			handler = route.handler
+			break
+		}
+	}
+
+	t.mu.Unlock()
+
+	if handler == nil {
+		return Response(r, http.StatusNotFound, fmt.Sprintf(
+			`{"errors": [{"reason": "mocktest: no handler for %s %s"}]}`, r.Method, path,
+		)), nil
+	}
+
+	return handler(r)
+}
+
+// Response returns a response to r with the given status and JSON body
+func Response(r *http.Request, status int, body string) *http.Response {
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", status, http.StatusText(status)),
+		StatusCode:    status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{"Content-Type": {"application/json"}},
+		Body:          io.NopCloser(strings.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       r,
+	}
+}
+
+func splitPath(path string) []string {
+	return strings.Split(strings.Trim(path, "/"), "/")
+}
+
+func matchPath(pattern, segments []string) bool {
+	if len(pattern) != len(segments) {
+		return false
+	}
+
+	for i, segment := range pattern {
+		if segment != "*" && segment != segments[i] {
+			return false
+		}
+	}
+
+	return true
+}
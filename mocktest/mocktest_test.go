@@ -0,0 +1,61 @@
+package mocktest_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/linode/linodego"
+	"github.com/linode/linodego/mocktest"
+)
+
+func TestTransport(t *testing.T) {
+	mock := mocktest.New().
+		HandleJSON(http.MethodGet, "/linode/instances/123", http.StatusOK, map[string]any{"id": 123, "label": "mock"}).
+		HandleFunc(http.MethodPut, "/linode/instances/*", func(r *http.Request) (*http.Response, error) {
+			var opts linodego.InstanceUpdateOptions
+			if err := json.NewDecoder(r.Body).Decode(&opts); err != nil {
+				return nil, err
+			}
+
+			return mocktest.Response(r, http.StatusOK, `{"id": 123, "label": "`+opts.Label+`"}`), nil
+		})
+
+	client := linodego.NewClientFromRoundTripper(mock)
+
+	instance, err := client.GetInstance(context.Background(), 123)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if instance.Label != "mock" {
+		t.Errorf("expected the canned instance, got %v", instance)
+	}
+
+	instance, err = client.UpdateInstance(context.Background(), 123, linodego.InstanceUpdateOptions{Label: "updated"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if instance.Label != "updated" {
+		t.Errorf("expected the updated instance, got %v", instance)
+	}
+
+	requests := mock.Requests()
+	if len(requests) != 2 || requests[1].Method != http.MethodPut || requests[1].Path != "/linode/instances/123" {
+		t.Errorf("unexpected requests: %v", requests)
+	}
+}
+
+func TestTransport_NoHandler(t *testing.T) {
+	client := linodego.NewClientFromRoundTripper(mocktest.New())
+
+	_, err := client.GetInstance(context.Background(), 123)
+
+	var apiErr *linodego.Error
+	if !errors.As(err, &apiErr) || apiErr.Code != http.StatusNotFound {
+		t.Fatalf("expected a 404 error, got %v", err)
+	}
+}
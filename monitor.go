@@ -0,0 +1,407 @@
+package linodego
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/linode/linodego/internal/parseabletime"
+)
+
+// MonitorAPIURL is the default URL of the Linode Monitor (ACLP) metrics API, see SetMonitorAPIURL
+const MonitorAPIURL = "https://monitor-api.linode.com/v2"
+
+// monitorTokenExpiryMargin is how long before their expiry cached service tokens are renewed
+const monitorTokenExpiryMargin = time.Minute
+
+// monitorTokenCache holds the service tokens used by QueryMonitorMetrics for a Client and its copies,
+// keyed by their service type and entities.
+type monitorTokenCache struct {
+	mu     sync.Mutex
+	tokens map[string]monitorCachedToken
+}
+
+type monitorCachedToken struct {
+	token  string
+	expiry time.Time
+}
+
+// MonitorDashboard is a predefined dashboard of metrics for a service type, e.g. "dbaas"
+type MonitorDashboard struct {
+	ID          int                      `json:"id"`
+	Label       string                   `json:"label"`
+	ServiceType string                   `json:"service_type"`
+	Type        string                   `json:"type"`
+	Widgets     []MonitorDashboardWidget `json:"widgets"`
+	Created     *time.Time               `json:"-"`
+	Updated     *time.Time               `json:"-"`
+}
+
+// MonitorDashboardWidget is a chart of a metric on a MonitorDashboard
+type MonitorDashboardWidget struct {
+	Metric            string `json:"metric"`
+	Unit              string `json:"unit"`
+	Label             string `json:"label"`
+	Color             string `json:"color"`
+	Size              int    `json:"size"`
+	ChartType         string `json:"chart_type"`
+	YLabel            string `json:"y_label"`
+	AggregateFunction string `json:"aggregate_function"`
+}
+
+// MonitorMetricDefinition describes a metric available for a service type
+type MonitorMetricDefinition struct {
+	Label                       string                   `json:"label"`
+	Metric                      string                   `json:"metric"`
+	MetricType                  string                   `json:"metric_type"`
+	Unit                        string                   `json:"unit"`
+	ScrapeInterval              string                   `json:"scrape_interval"`
+	IsAlertable                 bool                     `json:"is_alertable"`
+	AvailableAggregateFunctions []string                 `json:"available_aggregate_functions"`
+	Dimensions                  []MonitorMetricDimension `json:"dimensions"`
+}
+
+// MonitorMetricDimension is a dimension by which a metric can be filtered or grouped
+type MonitorMetricDimension struct {
+	DimensionLabel string   `json:"dimension_label"`
+	Label          string   `json:"label"`
+	Values         []string `json:"values"`
+}
+
+// MonitorServiceToken is a short-lived JWT that authorizes metrics queries for the entities it was created for
+type MonitorServiceToken struct {
+	Token string `json:"token"`
+}
+
+// MonitorServiceTokenCreateOptions fields are those accepted by GetMonitorServiceToken
+type MonitorServiceTokenCreateOptions struct {
+	EntityIDs []int `json:"entity_ids"`
+}
+
+// MetricsQuery is a query of the metrics of entities of a service type, see QueryMonitorMetrics.
+// Either RelativeTimeDuration or AbsoluteTimeDuration must be set.
+type MetricsQuery struct {
+	EntityIDs            []int                        `json:"entity_ids"`
+	Metrics              []MetricsQueryMetric         `json:"metrics"`
+	RelativeTimeDuration *MetricsTimeDuration         `json:"relative_time_duration,omitempty"`
+	AbsoluteTimeDuration *MetricsAbsoluteTimeDuration `json:"absolute_time_duration,omitempty"`
+	TimeGranularity      *MetricsTimeDuration         `json:"time_granularity,omitempty"`
+	Filters              []MetricsFilter              `json:"filters,omitempty"`
+	GroupBy              []string                     `json:"group_by,omitempty"`
+}
+
+// MetricsQueryMetric is a metric of a MetricsQuery and the function used to aggregate it
+type MetricsQueryMetric struct {
+	Name              string `json:"name"`
+	AggregateFunction string `json:"aggregate_function"`
+}
+
+// MetricsTimeDuration is a duration such as 30 "min", in units of "min", "hr" or "days"
+type MetricsTimeDuration struct {
+	Unit  string `json:"unit"`
+	Value int    `json:"value"`
+}
+
+// MetricsAbsoluteTimeDuration is the time window of a MetricsQuery
+type MetricsAbsoluteTimeDuration struct {
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
+}
+
+// MetricsFilter restricts a MetricsQuery to the values of a metric dimension
+type MetricsFilter struct {
+	DimensionLabel string `json:"dimension_label"`
+	Operator       string `json:"operator"`
+	Value          string `json:"value"`
+}
+
+// MetricsResponse is the time-series data returned by QueryMonitorMetrics
+type MetricsResponse struct {
+	Status    string      `json:"status"`
+	IsPartial bool        `json:"isPartial"`
+	Data      MetricsData `json:"data"`
+}
+
+// MetricsData holds the series of a MetricsResponse
+type MetricsData struct {
+	ResultType string          `json:"resultType"`
+	Result     []MetricsResult `json:"result"`
+}
+
+// MetricsResult is a single series, identified by the labels in Metric
+type MetricsResult struct {
+	Metric map[string]string `json:"metric"`
+	Values []MetricsValue    `json:"values"`
+}
+
+// MetricsValue is a sample of a MetricsResult
+type MetricsValue struct {
+	Time  time.Time
+	Value float64
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for samples
+// encoded as a pair of a Unix timestamp and a string value, e.g. [1700000000, "1.5"]
+func (v *MetricsValue) UnmarshalJSON(b []byte) error {
+	var sample []json.RawMessage
+	if err := json.Unmarshal(b, &sample); err != nil {
+		return err
+	}
+
+	if len(sample) != 2 {
+		return fmt.Errorf("expected a timestamp and a value, got %s", b)
+	}
+
+	var timestamp float64
+	if err := json.Unmarshal(sample[0], &timestamp); err != nil {
+		return fmt.Errorf("invalid timestamp %s: %w", sample[0], err)
+	}
+
+	var value string
+	if err := json.Unmarshal(sample[1], &value); err != nil {
+		return fmt.Errorf("invalid value %s: %w", sample[1], err)
+	}
+
+	parsed, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return fmt.Errorf("invalid value %q: %w", value, err)
+	}
+
+	seconds := int64(timestamp)
+	v.Time = time.Unix(seconds, int64((timestamp-float64(seconds))*float64(time.Second))).UTC()
+	v.Value = parsed
+
+	return nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (i *MonitorDashboard) UnmarshalJSON(b []byte) error {
+	type Mask MonitorDashboard
+
+	p := struct {
+		*Mask
+		Created *parseabletime.ParseableTime `json:"created"`
+		Updated *parseabletime.ParseableTime `json:"updated"`
+	}{
+		Mask: (*Mask)(i),
+	}
+
+	if err := json.Unmarshal(b, &p); err != nil {
+		return err
+	}
+
+	i.Created = (*time.Time)(p.Created)
+	i.Updated = (*time.Time)(p.Updated)
+
+	return nil
+}
+
+// MonitorDashboardsPagedResponse represents a paginated Monitor Dashboard API response
+type MonitorDashboardsPagedResponse struct {
+	*PageOptions
+	Data []MonitorDashboard `json:"data"`
+}
+
+// endpoint gets the endpoint URL for MonitorDashboard
+func (MonitorDashboardsPagedResponse) endpoint(_ ...any) string {
+	return "monitor/dashboards"
+}
+
+func (resp *MonitorDashboardsPagedResponse) castResult(r *resty.Request, e string) (int, int, error) {
+	res, err := coupleAPIErrors(r.SetResult(MonitorDashboardsPagedResponse{}).Get(e))
+	if err != nil {
+		return 0, 0, err
+	}
+	castedRes := res.Result().(*MonitorDashboardsPagedResponse)
+	resp.Data = append(resp.Data, castedRes.Data...)
+	return castedRes.Pages, castedRes.Results, nil
+}
+
+// MonitorMetricDefinitionsPagedResponse represents a paginated Monitor Metric Definition API response
+type MonitorMetricDefinitionsPagedResponse struct {
+	*PageOptions
+	Data []MonitorMetricDefinition `json:"data"`
+}
+
+// endpoint gets the endpoint URL for MonitorMetricDefinition
+func (MonitorMetricDefinitionsPagedResponse) endpoint(ids ...any) string {
+	serviceType := url.PathEscape(ids[0].(string))
+	return fmt.Sprintf("monitor/services/%s/metric-definitions", serviceType)
+}
+
+func (resp *MonitorMetricDefinitionsPagedResponse) castResult(r *resty.Request, e string) (int, int, error) {
+	res, err := coupleAPIErrors(r.SetResult(MonitorMetricDefinitionsPagedResponse{}).Get(e))
+	if err != nil {
+		return 0, 0, err
+	}
+	castedRes := res.Result().(*MonitorMetricDefinitionsPagedResponse)
+	resp.Data = append(resp.Data, castedRes.Data...)
+	return castedRes.Pages, castedRes.Results, nil
+}
+
+// ListMonitorDashboards lists the Monitor Dashboards of all service types
+func (c *Client) ListMonitorDashboards(ctx context.Context, opts *ListOptions) ([]MonitorDashboard, error) {
+	response := MonitorDashboardsPagedResponse{}
+	err := c.listHelper(ctx, &response, opts)
+	if err != nil {
+		return nil, err
+	}
+	return response.Data, nil
+}
+
+// ListMonitorMetricDefinitions lists the metrics available for the service type, e.g. "dbaas"
+func (c *Client) ListMonitorMetricDefinitions(ctx context.Context, serviceType string, opts *ListOptions) ([]MonitorMetricDefinition, error) {
+	response := MonitorMetricDefinitionsPagedResponse{}
+	err := c.listHelper(ctx, &response, opts, serviceType)
+	if err != nil {
+		return nil, err
+	}
+	return response.Data, nil
+}
+
+// GetMonitorServiceToken creates a token authorizing metrics queries for the given entities of the
+// service type. Unlike the other endpoints, the metrics API does not accept the token of the Client.
+func (c *Client) GetMonitorServiceToken(
+	ctx context.Context, serviceType string, opts MonitorServiceTokenCreateOptions,
+) (*MonitorServiceToken, error) {
+	body, err := c.marshalJSON(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	// Creating a token does not change any resources, so it is sent in dry run mode too
+	ctx = context.WithValue(ctx, dryRunExemptContextKey{}, true)
+
+	e := fmt.Sprintf("monitor/services/%s/token", url.PathEscape(serviceType))
+	req := c.R(ctx).SetResult(&MonitorServiceToken{}).SetBody(string(body))
+	r, err := coupleAPIErrors(req.Post(e))
+	if err != nil {
+		return nil, err
+	}
+	return r.Result().(*MonitorServiceToken), nil
+}
+
+// SetMonitorAPIURL sets the URL of the metrics API used by QueryMonitorMetrics,
+// defaulting to MonitorAPIURL.
+func (c *Client) SetMonitorAPIURL(monitorAPIURL string) *Client {
+	c.monitorAPIURL = strings.TrimSuffix(monitorAPIURL, "/")
+	return c
+}
+
+// QueryMonitorMetrics queries the metrics of the entities of the service type, e.g. "dbaas".
+// A token for the entities of the query is created with GetMonitorServiceToken and sent to
+// the metrics API instead of the token of the Client, see SetMonitorAPIURL. The token is reused
+// by queries of the same entities until shortly before it expires.
+func (c *Client) QueryMonitorMetrics(ctx context.Context, serviceType string, query MetricsQuery) (*MetricsResponse, error) {
+	if len(query.EntityIDs) == 0 || len(query.Metrics) == 0 {
+		return nil, errors.New("a metrics query requires at least one entity and metric")
+	}
+
+	if (query.RelativeTimeDuration == nil) == (query.AbsoluteTimeDuration == nil) {
+		return nil, errors.New("a metrics query requires either a relative or an absolute time duration")
+	}
+
+	tokenKey := monitorTokenKey(serviceType, query.EntityIDs)
+
+	token, err := c.monitorServiceToken(ctx, serviceType, tokenKey, query.EntityIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get monitor service token: %w", err)
+	}
+
+	body, err := c.marshalJSON(query)
+	if err != nil {
+		return nil, err
+	}
+
+	monitorAPIURL := c.monitorAPIURL
+	if monitorAPIURL == "" {
+		monitorAPIURL = MonitorAPIURL
+	}
+
+	e := fmt.Sprintf("%s/monitor/services/%s/metrics", monitorAPIURL, url.PathEscape(serviceType))
+	// The query does not change any resources, so it is sent in dry run mode too
+	ctx = context.WithValue(ctx, dryRunExemptContextKey{}, true)
+
+	req := c.R(context.WithValue(ctx, requestAuthorizationContextKey{}, true)).
+		SetHeader("Authorization", "Bearer "+token).
+		SetResult(&MetricsResponse{}).
+		SetBody(string(body))
+
+	r, err := coupleAPIErrors(req.Post(e))
+	if err != nil {
+		if errors.Is(err, &Error{Code: http.StatusUnauthorized}) {
+			// The token may have been revoked before its expiry
+			c.forgetMonitorServiceToken(tokenKey)
+		}
+
+		return nil, err
+	}
+	return r.Result().(*MetricsResponse), nil
+}
+
+// monitorTokenKey identifies the service tokens of the entities of a service type regardless of their order
+func monitorTokenKey(serviceType string, entityIDs []int) string {
+	ids := append([]int(nil), entityIDs...)
+	sort.Ints(ids)
+
+	key := make([]string, 0, len(ids)+1)
+	key = append(key, serviceType)
+
+	for _, id := range ids {
+		key = append(key, strconv.Itoa(id))
+	}
+
+	return strings.Join(key, ":")
+}
+
+// monitorServiceToken returns a cached service token for the entities, creating one if none is
+// cached or the cached one is about to expire. Tokens without an expiry are not cached.
+func (c *Client) monitorServiceToken(ctx context.Context, serviceType, key string, entityIDs []int) (string, error) {
+	if c.monitorTokens != nil {
+		c.monitorTokens.mu.Lock()
+		cached, ok := c.monitorTokens.tokens[key]
+		c.monitorTokens.mu.Unlock()
+
+		if ok && time.Until(cached.expiry) > monitorTokenExpiryMargin {
+			return cached.token, nil
+		}
+	}
+
+	token, err := c.GetMonitorServiceToken(ctx, serviceType, MonitorServiceTokenCreateOptions{EntityIDs: entityIDs})
+	if err != nil {
+		return "", err
+	}
+
+	if expiry, ok := jwtExpiry(token.Token); ok && c.monitorTokens != nil {
+		c.monitorTokens.mu.Lock()
+		defer c.monitorTokens.mu.Unlock()
+
+		if c.monitorTokens.tokens == nil {
+			c.monitorTokens.tokens = make(map[string]monitorCachedToken)
+		}
+
+		c.monitorTokens.tokens[key] = monitorCachedToken{token: token.Token, expiry: expiry}
+	}
+
+	return token.Token, nil
+}
+
+func (c *Client) forgetMonitorServiceToken(key string) {
+	if c.monitorTokens == nil {
+		return
+	}
+
+	c.monitorTokens.mu.Lock()
+	defer c.monitorTokens.mu.Unlock()
+
+	delete(c.monitorTokens.tokens, key)
+}
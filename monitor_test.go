@@ -0,0 +1,136 @@
+package linodego
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"golang.org/x/oauth2"
+)
+
+func TestClient_QueryMonitorMetrics(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Set("Content-Type", "application/json")
+		body, _ := io.ReadAll(r.Body)
+
+		switch r.Method + " " + r.URL.Path {
+		case "POST /v4/monitor/services/dbaas/token":
+			if r.Header.Get("Authorization") != "Bearer account-token" || string(body) != `{"entity_ids":[123]}` {
+				t.Errorf("unexpected token request with %q: %s", r.Header.Get("Authorization"), body)
+			}
+
+			rw.Write([]byte(`{"token": "service-jwt"}`))
+		case "POST /v2/monitor/services/dbaas/metrics":
+			if r.Header.Get("Authorization") != "Bearer service-jwt" {
+				t.Errorf("expected the service token, got %q", r.Header.Get("Authorization"))
+			}
+
+			expected := `{"entity_ids":[123],"metrics":[{"name":"cpu_usage","aggregate_function":"avg"}],` +
+				`"relative_time_duration":{"unit":"min","value":30},"time_granularity":{"unit":"min","value":5}}`
+			if string(body) != expected {
+				t.Errorf("unexpected query %s", body)
+			}
+
+			rw.Write([]byte(`{"status": "success", "isPartial": false, "data": {"resultType": "matrix", "result": [
+				{"metric": {"entity_id": "123"}, "values": [[1700000000, "12.5"], [1700000300, "20"]]}
+			]}}`))
+		case "GET /v4/monitor/dashboards":
+			rw.Write([]byte(`{"data": [{"id": 1, "label": "Resource Usage", "service_type": "dbaas", "created": "2024-01-02T03:04:05",
+				"widgets": [{"metric": "cpu_usage", "aggregate_function": "avg", "chart_type": "line"}]}], "page": 1, "pages": 1, "results": 1}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			rw.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	client := NewClient(nil)
+	client.SetBaseURL(ts.URL)
+	client.SetTokenSource(oauth2.StaticTokenSource(&oauth2.Token{AccessToken: "account-token", TokenType: "Bearer"}))
+	client.SetMonitorAPIURL(ts.URL + "/v2/")
+
+	dashboards, err := client.ListMonitorDashboards(context.Background(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(dashboards) != 1 || dashboards[0].Created == nil || dashboards[0].Widgets[0].Metric != "cpu_usage" {
+		t.Errorf("unexpected dashboards %+v", dashboards)
+	}
+
+	metrics, err := client.QueryMonitorMetrics(context.Background(), "dbaas", MetricsQuery{
+		EntityIDs:            []int{123},
+		Metrics:              []MetricsQueryMetric{{Name: "cpu_usage", AggregateFunction: "avg"}},
+		RelativeTimeDuration: &MetricsTimeDuration{Unit: "min", Value: 30},
+		TimeGranularity:      &MetricsTimeDuration{Unit: "min", Value: 5},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(metrics.Data.Result) != 1 {
+		t.Fatalf("unexpected metrics %+v", metrics)
+	}
+
+	values := metrics.Data.Result[0].Values
+	if len(values) != 2 || values[0].Value != 12.5 || !values[1].Time.Equal(time.Unix(1700000300, 0)) {
+		t.Errorf("unexpected values %+v", values)
+	}
+
+	if _, err := client.QueryMonitorMetrics(context.Background(), "dbaas", MetricsQuery{EntityIDs: []int{123}}); err == nil {
+		t.Error("expected an error for a query without metrics")
+	}
+}
+
+func TestClient_QueryMonitorMetrics_tokenCache(t *testing.T) {
+	exp := time.Now().Add(time.Hour).Unix()
+	jwt := "header." + base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf(`{"exp":%d}`, exp))) + ".signature"
+
+	var tokenRequests, metricsRequests int
+
+	ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/v4/monitor/services/dbaas/token":
+			tokenRequests++
+			rw.Write([]byte(`{"token": "` + jwt + `"}`))
+		case "/v2/monitor/services/dbaas/metrics":
+			metricsRequests++
+			if r.Header.Get("Authorization") != "Bearer "+jwt {
+				t.Errorf("expected the service token, got %q", r.Header.Get("Authorization"))
+			}
+
+			rw.Write([]byte(`{"status": "success", "data": {"resultType": "matrix", "result": []}}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			rw.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	client := NewClient(nil)
+	client.SetBaseURL(ts.URL)
+	client.SetToken("account-token")
+	client.SetMonitorAPIURL(ts.URL + "/v2")
+	client.SetDryRun(true)
+
+	for _, ids := range [][]int{{1, 2}, {2, 1}} {
+		if _, err := client.QueryMonitorMetrics(context.Background(), "dbaas", MetricsQuery{
+			EntityIDs:            ids,
+			Metrics:              []MetricsQueryMetric{{Name: "cpu_usage", AggregateFunction: "avg"}},
+			RelativeTimeDuration: &MetricsTimeDuration{Unit: "min", Value: 30},
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if tokenRequests != 1 || metricsRequests != 2 {
+		t.Errorf("expected 1 token and 2 metrics requests, got %d and %d", tokenRequests, metricsRequests)
+	}
+}
@@ -25,6 +25,7 @@ type MySQLDatabase struct {
 	Status          DatabaseStatus            `json:"status"`
 	Label           string                    `json:"label"`
 	Hosts           DatabaseHost              `json:"hosts"`
+	Port            int                       `json:"port"`
 	Region          string                    `json:"region"`
 	Type            string                    `json:"type"`
 	Engine          string                    `json:"engine"`
@@ -200,7 +201,7 @@ func (c *Client) GetMySQLDatabase(ctx context.Context, databaseID int) (*MySQLDa
 
 // CreateMySQLDatabase creates a new MySQL Database using the createOpts as configuration, returns the new MySQL Database
 func (c *Client) CreateMySQLDatabase(ctx context.Context, opts MySQLCreateOptions) (*MySQLDatabase, error) {
-	body, err := json.Marshal(opts)
+	body, err := c.marshalJSON(opts)
 	if err != nil {
 		return nil, err
 	}
@@ -223,7 +224,7 @@ func (c *Client) DeleteMySQLDatabase(ctx context.Context, databaseID int) error
 
 // UpdateMySQLDatabase updates the given MySQL Database with the provided opts, returns the MySQLDatabase with the new settings
 func (c *Client) UpdateMySQLDatabase(ctx context.Context, databaseID int, opts MySQLUpdateOptions) (*MySQLDatabase, error) {
-	body, err := json.Marshal(opts)
+	body, err := c.marshalJSON(opts)
 	if err != nil {
 		return nil, err
 	}
@@ -290,7 +291,7 @@ func (c *Client) RestoreMySQLDatabaseBackup(ctx context.Context, databaseID int,
 
 // CreateMySQLDatabaseBackup creates a snapshot for the given MySQL database
 func (c *Client) CreateMySQLDatabaseBackup(ctx context.Context, databaseID int, opts MySQLBackupCreateOptions) error {
-	body, err := json.Marshal(opts)
+	body, err := c.marshalJSON(opts)
 	if err != nil {
 		return err
 	}
@@ -2,7 +2,6 @@ package linodego
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"net/url"
 
@@ -91,7 +90,7 @@ func (c *Client) GetIPAddress(ctx context.Context, id string) (*InstanceIP, erro
 
 // UpdateIPAddress updates the IPAddress with the specified id
 func (c *Client) UpdateIPAddress(ctx context.Context, id string, opts IPAddressUpdateOptions) (*InstanceIP, error) {
-	body, err := json.Marshal(opts)
+	body, err := c.marshalJSON(opts)
 	if err != nil {
 		return nil, err
 	}
@@ -109,7 +108,7 @@ func (c *Client) UpdateIPAddress(ctx context.Context, id string, opts IPAddressU
 // InstancesAssignIPs assigns multiple IPv4 addresses and/or IPv6 ranges to multiple Linodes in one Region.
 // This allows swapping, shuffling, or otherwise reorganizing IPs to your Linodes.
 func (c *Client) InstancesAssignIPs(ctx context.Context, opts LinodesAssignIPsOptions) error {
-	body, err := json.Marshal(opts)
+	body, err := c.marshalJSON(opts)
 	if err != nil {
 		return err
 	}
@@ -123,7 +122,7 @@ func (c *Client) InstancesAssignIPs(ctx context.Context, opts LinodesAssignIPsOp
 // ShareIPAddresses allows IP address reassignment (also referred to as IP failover)
 // from one Linode to another if the primary Linode becomes unresponsive.
 func (c *Client) ShareIPAddresses(ctx context.Context, opts IPAddressesShareOptions) error {
-	body, err := json.Marshal(opts)
+	body, err := c.marshalJSON(opts)
 	if err != nil {
 		return err
 	}
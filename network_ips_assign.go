@@ -0,0 +1,195 @@
+package linodego
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// ipAssignmentFieldRegexp matches the index of the assignment or shared IP an API error refers to
+var ipAssignmentFieldRegexp = regexp.MustCompile(`(?:assignments|ips)[.\[](\d+)`)
+
+// RejectedIPAssignment is an IP assignment that was rejected and the reason why
+type RejectedIPAssignment struct {
+	Assignment LinodeIPAssignment
+	Reason     string
+}
+
+// IPAssignmentError is returned by AssignIPsInRegion and ShareIPsWithInstance when
+// assignments were rejected, either by the client-side region check or by the API.
+type IPAssignmentError struct {
+	Rejected []RejectedIPAssignment
+
+	// Err is the error returned by the API, if any
+	Err error
+}
+
+func (e *IPAssignmentError) Error() string {
+	reasons := make([]string, len(e.Rejected))
+	for i, rejected := range e.Rejected {
+		reasons[i] = fmt.Sprintf("%s to Linode %d: %s", rejected.Assignment.Address, rejected.Assignment.LinodeID, rejected.Reason)
+	}
+
+	return fmt.Sprintf("%d IP assignments were rejected: %s", len(e.Rejected), strings.Join(reasons, "; "))
+}
+
+func (e *IPAssignmentError) Unwrap() error {
+	return e.Err
+}
+
+// AssignIPsInRegion assigns IPv4 addresses and IPv6 ranges to Linodes like InstancesAssignIPs,
+// after checking that all addresses and Linodes are in the given region. It returns the
+// updated addresses of each Linode of the assignments, keyed by Linode ID.
+// Rejected assignments are reported with an *IPAssignmentError.
+func (c *Client) AssignIPsInRegion(
+	ctx context.Context, region string, assignments []LinodeIPAssignment,
+) (map[int]*InstanceIPAddressResponse, error) {
+	if err := c.validateIPAssignmentRegions(ctx, region, assignments); err != nil {
+		return nil, err
+	}
+
+	err := c.InstancesAssignIPs(ctx, LinodesAssignIPsOptions{Region: region, Assignments: assignments})
+	if err != nil {
+		return nil, rejectedIPAssignmentsError(assignments, err)
+	}
+
+	layout := make(map[int]*InstanceIPAddressResponse)
+
+	for _, assignment := range assignments {
+		if _, ok := layout[assignment.LinodeID]; ok {
+			continue
+		}
+
+		ips, err := c.GetInstanceIPAddresses(ctx, assignment.LinodeID)
+		if err != nil {
+			return nil, err
+		}
+
+		layout[assignment.LinodeID] = ips
+	}
+
+	return layout, nil
+}
+
+// ShareIPsWithInstance shares IP addresses with a Linode like ShareIPAddresses, after checking
+// that all addresses are in the region of the Linode. It returns the updated addresses of the Linode.
+// Rejected addresses are reported with an *IPAssignmentError.
+func (c *Client) ShareIPsWithInstance(ctx context.Context, linodeID int, ips []string) (*InstanceIPAddressResponse, error) {
+	instance, err := c.GetInstance(ctx, linodeID)
+	if err != nil {
+		return nil, err
+	}
+
+	assignments := make([]LinodeIPAssignment, len(ips))
+	for i, ip := range ips {
+		assignments[i] = LinodeIPAssignment{Address: ip, LinodeID: linodeID}
+	}
+
+	if err := c.validateIPAssignmentRegions(ctx, instance.Region, assignments); err != nil {
+		return nil, err
+	}
+
+	if err := c.ShareIPAddresses(ctx, IPAddressesShareOptions{IPs: ips, LinodeID: linodeID}); err != nil {
+		return nil, rejectedIPAssignmentsError(assignments, err)
+	}
+
+	return c.GetInstanceIPAddresses(ctx, linodeID)
+}
+
+// validateIPAssignmentRegions returns an *IPAssignmentError for the assignments
+// with an address or Linode outside of region.
+func (c *Client) validateIPAssignmentRegions(ctx context.Context, region string, assignments []LinodeIPAssignment) error {
+	linodeRegions := make(map[int]string)
+
+	var assignmentErr IPAssignmentError
+
+	for _, assignment := range assignments {
+		linodeRegion, ok := linodeRegions[assignment.LinodeID]
+		if !ok {
+			instance, err := c.GetInstance(ctx, assignment.LinodeID)
+			if err != nil {
+				return fmt.Errorf("failed to get Linode %d: %w", assignment.LinodeID, err)
+			}
+
+			linodeRegion = instance.Region
+			linodeRegions[assignment.LinodeID] = linodeRegion
+		}
+
+		addressRegion, err := c.ipAddressRegion(ctx, assignment.Address)
+		if err != nil {
+			return fmt.Errorf("failed to get IP address %s: %w", assignment.Address, err)
+		}
+
+		var reason string
+
+		switch {
+		case addressRegion != region:
+			reason = fmt.Sprintf("address is in region %s, not %s", addressRegion, region)
+		case linodeRegion != region:
+			reason = fmt.Sprintf("Linode is in region %s, not %s", linodeRegion, region)
+		default:
+			continue
+		}
+
+		assignmentErr.Rejected = append(assignmentErr.Rejected, RejectedIPAssignment{Assignment: assignment, Reason: reason})
+	}
+
+	if len(assignmentErr.Rejected) > 0 {
+		return &assignmentErr
+	}
+
+	return nil
+}
+
+// ipAddressRegion returns the region of an IPv4 or IPv6 address, or of an IPv6 range in CIDR notation.
+func (c *Client) ipAddressRegion(ctx context.Context, address string) (string, error) {
+	if prefix, _, isRange := strings.Cut(address, "/"); isRange {
+		ipRange, err := c.GetIPv6Range(ctx, prefix)
+		if err != nil {
+			return "", err
+		}
+
+		return ipRange.Region, nil
+	}
+
+	ip, err := c.GetIPAddress(ctx, address)
+	if err != nil {
+		return "", err
+	}
+
+	return ip.Region, nil
+}
+
+// rejectedIPAssignmentsError maps the reasons of an API error to the assignments they refer to.
+// The error is returned unchanged if none of its reasons refer to an assignment.
+func rejectedIPAssignmentsError(assignments []LinodeIPAssignment, err error) error {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return err
+	}
+
+	assignmentErr := IPAssignmentError{Err: err}
+
+	for _, reason := range apiErr.Errors {
+		match := ipAssignmentFieldRegexp.FindStringSubmatch(reason.Field)
+		if match == nil {
+			continue
+		}
+
+		if index, convErr := strconv.Atoi(match[1]); convErr == nil && index < len(assignments) {
+			assignmentErr.Rejected = append(assignmentErr.Rejected, RejectedIPAssignment{
+				Assignment: assignments[index],
+				Reason:     reason.Reason,
+			})
+		}
+	}
+
+	if len(assignmentErr.Rejected) == 0 {
+		return err
+	}
+
+	return &assignmentErr
+}
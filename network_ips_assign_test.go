@@ -0,0 +1,114 @@
+package linodego
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func newIPAssignmentTestServer(t *testing.T, assignResponse func(rw http.ResponseWriter)) *httptest.Server {
+	t.Helper()
+
+	return httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/v4/linode/instances/1":
+			rw.Write([]byte(`{"id": 1, "region": "us-east"}`))
+		case "/v4/linode/instances/2":
+			rw.Write([]byte(`{"id": 2, "region": "us-west"}`))
+		case "/v4/networking/ips/192.0.2.1":
+			rw.Write([]byte(`{"address": "192.0.2.1", "region": "us-east"}`))
+		case "/v4/networking/ips/192.0.2.2":
+			rw.Write([]byte(`{"address": "192.0.2.2", "region": "us-east"}`))
+		case "/v4/networking/ipv6/ranges/2600:3c03::":
+			rw.Write([]byte(`{"range": "2600:3c03::", "prefix": 64, "region": "eu-west"}`))
+		case "/v4/networking/ips/assign", "/v4/networking/ips/share":
+			assignResponse(rw)
+		case "/v4/linode/instances/1/ips":
+			rw.Write([]byte(`{"ipv4": {"public": [{"address": "192.0.2.1"}, {"address": "192.0.2.2"}]}}`))
+		default:
+			rw.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func TestClient_AssignIPsInRegion(t *testing.T) {
+	ts := newIPAssignmentTestServer(t, func(rw http.ResponseWriter) {
+		rw.Write([]byte(`{}`))
+	})
+	defer ts.Close()
+
+	client := NewClient(nil)
+	client.SetBaseURL(ts.URL)
+
+	layout, err := client.AssignIPsInRegion(context.Background(), "us-east", []LinodeIPAssignment{
+		{Address: "192.0.2.1", LinodeID: 1},
+		{Address: "192.0.2.2", LinodeID: 1},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(layout) != 1 || len(layout[1].IPv4.Public) != 2 {
+		t.Errorf("unexpected networking layout: %v", layout)
+	}
+}
+
+func TestClient_AssignIPsInRegion_RegionMismatch(t *testing.T) {
+	ts := newIPAssignmentTestServer(t, func(rw http.ResponseWriter) {
+		t.Error("expected no assignment request")
+	})
+	defer ts.Close()
+
+	client := NewClient(nil)
+	client.SetBaseURL(ts.URL)
+
+	_, err := client.AssignIPsInRegion(context.Background(), "us-east", []LinodeIPAssignment{
+		{Address: "192.0.2.1", LinodeID: 1},
+		{Address: "192.0.2.2", LinodeID: 2},
+		{Address: "2600:3c03::/64", LinodeID: 1},
+	})
+
+	var assignmentErr *IPAssignmentError
+	if !errors.As(err, &assignmentErr) {
+		t.Fatalf("expected an IPAssignmentError, got %v", err)
+	}
+
+	if len(assignmentErr.Rejected) != 2 ||
+		assignmentErr.Rejected[0].Assignment.LinodeID != 2 ||
+		assignmentErr.Rejected[1].Assignment.Address != "2600:3c03::/64" {
+		t.Errorf("unexpected rejected assignments: %v", assignmentErr.Rejected)
+	}
+}
+
+func TestClient_ShareIPsWithInstance_Rejected(t *testing.T) {
+	ts := newIPAssignmentTestServer(t, func(rw http.ResponseWriter) {
+		rw.WriteHeader(http.StatusBadRequest)
+		rw.Write([]byte(`{"errors": [{"field": "ips[1]", "reason": "Address is already shared"}]}`))
+	})
+	defer ts.Close()
+
+	client := NewClient(nil)
+	client.SetBaseURL(ts.URL)
+
+	_, err := client.ShareIPsWithInstance(context.Background(), 1, []string{"192.0.2.1", "192.0.2.2"})
+
+	var assignmentErr *IPAssignmentError
+	if !errors.As(err, &assignmentErr) {
+		t.Fatalf("expected an IPAssignmentError, got %v", err)
+	}
+
+	if len(assignmentErr.Rejected) != 1 ||
+		assignmentErr.Rejected[0].Assignment.Address != "192.0.2.2" ||
+		assignmentErr.Rejected[0].Reason != "Address is already shared" {
+		t.Errorf("unexpected rejected assignments: %v", assignmentErr.Rejected)
+	}
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Errorf("expected the API error to be wrapped, got %v", err)
+	}
+}
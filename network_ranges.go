@@ -2,7 +2,7 @@ package linodego
 
 import (
 	"context"
-	"encoding/json"
+	"errors"
 	"fmt"
 	"net/url"
 
@@ -22,6 +22,20 @@ type IPv6RangeCreateOptions struct {
 	RouteTarget  string `json:"route_target,omitempty"`
 }
 
+// Validate checks that the options route the range to either a Linode or a route target
+// and that the prefix length is one accepted by the API.
+func (opts IPv6RangeCreateOptions) Validate() error {
+	if (opts.LinodeID == 0) == (opts.RouteTarget == "") {
+		return errors.New("exactly one of a Linode ID or a route target is required to create an IPv6 range")
+	}
+
+	if opts.PrefixLength != 56 && opts.PrefixLength != 64 {
+		return fmt.Errorf("invalid IPv6 range prefix length %d, must be 56 or 64", opts.PrefixLength)
+	}
+
+	return nil
+}
+
 // endpoint gets the endpoint URL for IPv6Range
 func (IPv6RangesPagedResponse) endpoint(_ ...any) string {
 	return "networking/ipv6/ranges"
@@ -47,6 +61,28 @@ func (c *Client) ListIPv6Ranges(ctx context.Context, opts *ListOptions) ([]IPv6R
 	return response.Data, nil
 }
 
+// ListIPv6RangeAllocations lists IPv6Ranges like ListIPv6Ranges and gets the details of each range,
+// so that the Linodes each range is routed to and whether it uses BGP are included.
+// This makes one additional request per listed range.
+func (c *Client) ListIPv6RangeAllocations(ctx context.Context, opts *ListOptions) ([]IPv6Range, error) {
+	ranges, err := c.ListIPv6Ranges(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, r := range ranges {
+		details, err := c.GetIPv6Range(ctx, r.Range)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get IPv6 range %s: %w", r.Range, err)
+		}
+
+		ranges[i].IsBGP = details.IsBGP
+		ranges[i].Linodes = details.Linodes
+	}
+
+	return ranges, nil
+}
+
 // GetIPv6Range gets details about an IPv6 range
 func (c *Client) GetIPv6Range(ctx context.Context, ipRange string) (*IPv6Range, error) {
 	ipRange = url.PathEscape(ipRange)
@@ -61,7 +97,11 @@ func (c *Client) GetIPv6Range(ctx context.Context, ipRange string) (*IPv6Range,
 
 // CreateIPv6Range creates an IPv6 Range and assigns it based on the provided Linode or route target IPv6 SLAAC address.
 func (c *Client) CreateIPv6Range(ctx context.Context, opts IPv6RangeCreateOptions) (*IPv6Range, error) {
-	body, err := json.Marshal(opts)
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+
+	body, err := c.marshalJSON(opts)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,59 @@
+package linodego
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestIPv6RangeCreateOptions_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    IPv6RangeCreateOptions
+		wantErr bool
+	}{
+		{"linode", IPv6RangeCreateOptions{LinodeID: 123, PrefixLength: 64}, false},
+		{"route target", IPv6RangeCreateOptions{RouteTarget: "2600:3c01::2", PrefixLength: 56}, false},
+		{"no target", IPv6RangeCreateOptions{PrefixLength: 64}, true},
+		{"both targets", IPv6RangeCreateOptions{LinodeID: 123, RouteTarget: "2600:3c01::2", PrefixLength: 64}, true},
+		{"invalid prefix", IPv6RangeCreateOptions{LinodeID: 123, PrefixLength: 48}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.opts.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("expected error %t, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestClient_ListIPv6RangeAllocations(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/v4/networking/ipv6/ranges":
+			rw.Write([]byte(`{"data": [{"range": "2600:3c01::", "prefix": 64, "region": "us-east"}], "page": 1, "pages": 1, "results": 1}`))
+		case "/v4/networking/ipv6/ranges/2600:3c01::":
+			rw.Write([]byte(`{"range": "2600:3c01::", "prefix": 64, "region": "us-east", "is_bgp": false, "linodes": [123, 456]}`))
+		default:
+			rw.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	client := NewClient(nil)
+	client.SetBaseURL(ts.URL)
+
+	ranges, err := client.ListIPv6RangeAllocations(context.Background(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(ranges) != 1 || !reflect.DeepEqual(ranges[0].Linodes, []int{123, 456}) {
+		t.Errorf("expected the range to include its Linodes, got %v", ranges)
+	}
+}
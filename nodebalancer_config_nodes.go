@@ -2,24 +2,34 @@ package linodego
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/go-resty/resty/v2"
 )
 
 // NodeBalancerNode objects represent a backend that can accept traffic for a NodeBalancer Config
 type NodeBalancerNode struct {
-	ID             int      `json:"id"`
-	Address        string   `json:"address"`
-	Label          string   `json:"label"`
-	Status         string   `json:"status"`
-	Weight         int      `json:"weight"`
-	Mode           NodeMode `json:"mode"`
-	ConfigID       int      `json:"config_id"`
-	NodeBalancerID int      `json:"nodebalancer_id"`
+	ID             int                    `json:"id"`
+	Address        string                 `json:"address"`
+	Label          string                 `json:"label"`
+	Status         NodeBalancerNodeHealth `json:"status"`
+	Weight         int                    `json:"weight"`
+	Mode           NodeMode               `json:"mode"`
+	ConfigID       int                    `json:"config_id"`
+	NodeBalancerID int                    `json:"nodebalancer_id"`
 }
 
+// NodeBalancerNodeHealth is the health of a NodeBalancer Node as determined by the health checks of its Config
+type NodeBalancerNodeHealth string
+
+// NodeBalancerNodeHealth constants reflect the health of a NodeBalancer Node
+const (
+	NodeBalancerNodeUp      NodeBalancerNodeHealth = "UP"
+	NodeBalancerNodeDown    NodeBalancerNodeHealth = "DOWN"
+	NodeBalancerNodeUnknown NodeBalancerNodeHealth = "unknown"
+)
+
 // NodeMode is the mode a NodeBalancer should use when sending traffic to a NodeBalancer Node
 type NodeMode string
 
@@ -119,7 +129,7 @@ func (c *Client) GetNodeBalancerNode(ctx context.Context, nodebalancerID int, co
 
 // CreateNodeBalancerNode creates a NodeBalancerNode
 func (c *Client) CreateNodeBalancerNode(ctx context.Context, nodebalancerID int, configID int, opts NodeBalancerNodeCreateOptions) (*NodeBalancerNode, error) {
-	body, err := json.Marshal(opts)
+	body, err := c.marshalJSON(opts)
 	if err != nil {
 		return nil, err
 	}
@@ -135,7 +145,7 @@ func (c *Client) CreateNodeBalancerNode(ctx context.Context, nodebalancerID int,
 
 // UpdateNodeBalancerNode updates the NodeBalancerNode with the specified id
 func (c *Client) UpdateNodeBalancerNode(ctx context.Context, nodebalancerID int, configID int, nodeID int, opts NodeBalancerNodeUpdateOptions) (*NodeBalancerNode, error) {
-	body, err := json.Marshal(opts)
+	body, err := c.marshalJSON(opts)
 	if err != nil {
 		return nil, err
 	}
@@ -155,3 +165,43 @@ func (c *Client) DeleteNodeBalancerNode(ctx context.Context, nodebalancerID int,
 	_, err := coupleAPIErrors(c.R(ctx).Delete(e))
 	return err
 }
+
+// DrainNodeBalancerNode sets the mode of a NodeBalancer Node to drain, so that it receives no new
+// connections while its existing connections are served, and returns the node once drainSeconds have elapsed.
+// The API does not report the connections of a single node, so drainSeconds should be at least the time
+// existing connections take to finish, e.g. the client timeout of the Config. The node is polled while
+// draining and an error is returned if it is deleted or its mode is changed from drain in the meantime.
+func (c *Client) DrainNodeBalancerNode(ctx context.Context, nodebalancerID int, configID int, nodeID int, drainSeconds int) (*NodeBalancerNode, error) {
+	node, err := c.UpdateNodeBalancerNode(ctx, nodebalancerID, configID, nodeID, NodeBalancerNodeUpdateOptions{Mode: ModeDrain})
+	if err != nil {
+		return nil, err
+	}
+
+	drained := time.NewTimer(time.Duration(drainSeconds) * time.Second)
+	defer drained.Stop()
+
+	ticker := time.NewTicker(c.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			node, err = c.GetNodeBalancerNode(ctx, nodebalancerID, configID, nodeID)
+			if err != nil {
+				if ctx.Err() != nil {
+					err = ctx.Err()
+				}
+
+				return nil, fmt.Errorf("Error draining NodeBalancer %d Node %d: %w", nodebalancerID, nodeID, err)
+			}
+
+			if node.Mode != ModeDrain {
+				return node, fmt.Errorf("Error draining NodeBalancer %d Node %d: mode was changed to %s", nodebalancerID, nodeID, node.Mode)
+			}
+		case <-drained.C:
+			return node, nil
+		case <-ctx.Done():
+			return node, fmt.Errorf("Error draining NodeBalancer %d Node %d: %w", nodebalancerID, nodeID, ctx.Err())
+		}
+	}
+}
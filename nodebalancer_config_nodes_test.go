@@ -0,0 +1,74 @@
+package linodego
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func newDrainTestServer(t *testing.T, modeAfterUpdate func(polls int) NodeMode) *httptest.Server {
+	t.Helper()
+
+	polls := 0
+
+	return httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v4/nodebalancers/1/configs/2/nodes/3" {
+			rw.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		mode := ModeDrain
+		if r.Method == http.MethodGet {
+			polls++
+			mode = modeAfterUpdate(polls)
+		}
+
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(`{"id": 3, "status": "UP", "mode": "` + string(mode) + `"}`))
+	}))
+}
+
+func TestClient_DrainNodeBalancerNode(t *testing.T) {
+	ts := newDrainTestServer(t, func(int) NodeMode { return ModeDrain })
+	defer ts.Close()
+
+	client := NewClient(nil)
+	client.SetBaseURL(ts.URL)
+	client.SetPollDelay(time.Millisecond)
+
+	node, err := client.DrainNodeBalancerNode(context.Background(), 1, 2, 3, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if node.Mode != ModeDrain {
+		t.Errorf("expected the node to be draining, got %s", node.Mode)
+	}
+}
+
+func TestClient_DrainNodeBalancerNode_ModeChanged(t *testing.T) {
+	ts := newDrainTestServer(t, func(polls int) NodeMode {
+		if polls > 1 {
+			return ModeAccept
+		}
+
+		return ModeDrain
+	})
+	defer ts.Close()
+
+	client := NewClient(nil)
+	client.SetBaseURL(ts.URL)
+	client.SetPollDelay(time.Millisecond)
+
+	node, err := client.DrainNodeBalancerNode(context.Background(), 1, 2, 3, 5)
+	if err == nil || !strings.Contains(err.Error(), "mode was changed to accept") {
+		t.Fatalf("expected a mode change error, got %v", err)
+	}
+
+	if node.Mode != ModeAccept {
+		t.Errorf("expected the final node to be returned, got %v", node)
+	}
+}
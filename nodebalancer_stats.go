@@ -3,6 +3,7 @@ package linodego
 import (
 	"context"
 	"fmt"
+	"time"
 )
 
 // NodeBalancerStats represents a nodebalancer stats object
@@ -23,6 +24,46 @@ type StatsTraffic struct {
 	Out [][]float64 `json:"out"`
 }
 
+// StatsDataPoint is a single value of a stats time series
+type StatsDataPoint struct {
+	Time  time.Time
+	Value float64
+}
+
+// ConnectionPoints returns the connections time series as data points
+func (d NodeBalancerStatsData) ConnectionPoints() []StatsDataPoint {
+	return parseStatsDataPoints(d.Connections)
+}
+
+// InPoints returns the inbound traffic time series as data points
+func (t StatsTraffic) InPoints() []StatsDataPoint {
+	return parseStatsDataPoints(t.In)
+}
+
+// OutPoints returns the outbound traffic time series as data points
+func (t StatsTraffic) OutPoints() []StatsDataPoint {
+	return parseStatsDataPoints(t.Out)
+}
+
+// parseStatsDataPoints converts the [timestamp, value] pairs of a stats time series to data points.
+// Timestamps are in milliseconds since the Unix epoch, and incomplete pairs are skipped.
+func parseStatsDataPoints(series [][]float64) []StatsDataPoint {
+	points := make([]StatsDataPoint, 0, len(series))
+
+	for _, pair := range series {
+		if len(pair) < 2 {
+			continue
+		}
+
+		points = append(points, StatsDataPoint{
+			Time:  time.UnixMilli(int64(pair[0])).UTC(),
+			Value: pair[1],
+		})
+	}
+
+	return points
+}
+
 // GetNodeBalancerStats gets the template with the provided ID
 func (c *Client) GetNodeBalancerStats(ctx context.Context, nodebalancerID int) (*NodeBalancerStats, error) {
 	e := fmt.Sprintf("nodebalancers/%d/stats", nodebalancerID)
@@ -0,0 +1,37 @@
+package linodego
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestNodeBalancerStatsData_Points(t *testing.T) {
+	var stats NodeBalancerStats
+	if err := json.Unmarshal([]byte(`{
+		"title": "example",
+		"data": {
+			"connections": [[1700000000000, 5], [1700000300000, 7], [1700000600000]],
+			"traffic": {"in": [[1700000000000, 1024.5]], "out": []}
+		}
+	}`), &stats); err != nil {
+		t.Fatal(err)
+	}
+
+	connections := stats.Data.ConnectionPoints()
+	if len(connections) != 2 {
+		t.Fatalf("expected incomplete pairs to be skipped, got %v", connections)
+	}
+
+	if !connections[1].Time.Equal(time.Date(2023, 11, 14, 22, 18, 20, 0, time.UTC)) || connections[1].Value != 7 {
+		t.Errorf("unexpected data point: %v", connections[1])
+	}
+
+	if in := stats.Data.Traffic.InPoints(); len(in) != 1 || in[0].Value != 1024.5 {
+		t.Errorf("unexpected inbound traffic: %v", in)
+	}
+
+	if out := stats.Data.Traffic.OutPoints(); len(out) != 0 {
+		t.Errorf("expected no outbound traffic, got %v", out)
+	}
+}
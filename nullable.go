@@ -0,0 +1,118 @@
+package linodego
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// NullableInt and NullableString are used by options whose values can be cleared by sending null,
+// which a pointer tagged with omitempty cannot express, e.g. DefaultFirewallIDsUpdateOptions. A nil *NullableInt or *NullableString tagged
+// with omitempty is omitted and leaves the value unchanged, a value that is not Valid sends null and
+// a Valid value sends its value. When decoding, null decodes to a nil pointer or a value that is not Valid.
+// Existing fields keep their types for compatibility, and nullable response fields remain pointers,
+// e.g. Volume.LinodeID. Volumes are detached with DetachVolume, as UpdateVolume does not accept a linode_id.
+
+var jsonNull = []byte("null")
+
+// NullableInt is an int that can be explicitly set to null in options
+type NullableInt struct {
+	Value int
+	Valid bool
+}
+
+// NewNullableInt returns a NullableInt of the value, or null if the value is nil.
+// This converts nullable response fields, e.g. Volume.LinodeID, for use in options.
+func NewNullableInt(value *int) *NullableInt {
+	if value == nil {
+		return &NullableInt{}
+	}
+
+	return &NullableInt{Value: *value, Valid: true}
+}
+
+// Ptr returns a pointer to the value of the NullableInt, or nil if it is null
+func (n NullableInt) Ptr() *int {
+	if !n.Valid {
+		return nil
+	}
+
+	value := n.Value
+
+	return &value
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (n NullableInt) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return jsonNull, nil
+	}
+
+	return json.Marshal(n.Value)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (n *NullableInt) UnmarshalJSON(b []byte) error {
+	if bytes.Equal(bytes.TrimSpace(b), jsonNull) {
+		*n = NullableInt{}
+		return nil
+	}
+
+	if err := json.Unmarshal(b, &n.Value); err != nil {
+		return err
+	}
+
+	n.Valid = true
+
+	return nil
+}
+
+// NullableString is a string that can be explicitly set to null in options
+type NullableString struct {
+	Value string
+	Valid bool
+}
+
+// NewNullableString returns a NullableString of the value, or null if the value is nil.
+func NewNullableString(value *string) *NullableString {
+	if value == nil {
+		return &NullableString{}
+	}
+
+	return &NullableString{Value: *value, Valid: true}
+}
+
+// Ptr returns a pointer to the value of the NullableString, or nil if it is null
+func (n NullableString) Ptr() *string {
+	if !n.Valid {
+		return nil
+	}
+
+	value := n.Value
+
+	return &value
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (n NullableString) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return jsonNull, nil
+	}
+
+	return json.Marshal(n.Value)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (n *NullableString) UnmarshalJSON(b []byte) error {
+	if bytes.Equal(bytes.TrimSpace(b), jsonNull) {
+		*n = NullableString{}
+		return nil
+	}
+
+	if err := json.Unmarshal(b, &n.Value); err != nil {
+		return err
+	}
+
+	n.Valid = true
+
+	return nil
+}
@@ -0,0 +1,65 @@
+package linodego
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestNullableInt(t *testing.T) {
+	linodeID := 123
+
+	type options struct {
+		LinodeID *NullableInt    `json:"linode_id,omitempty"`
+		Label    *NullableString `json:"label,omitempty"`
+	}
+
+	for _, tc := range []struct {
+		opts     options
+		expected string
+	}{
+		{options{}, `{}`},
+		{options{LinodeID: NewNullableInt(nil), Label: NewNullableString(nil)}, `{"linode_id":null,"label":null}`},
+		{options{LinodeID: NewNullableInt(&linodeID), Label: &NullableString{Valid: true}}, `{"linode_id":123,"label":""}`},
+	} {
+		body, err := json.Marshal(tc.opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if string(body) != tc.expected {
+			t.Errorf("expected %s, got %s", tc.expected, body)
+		}
+
+	}
+
+	var decoded struct {
+		LinodeID NullableInt    `json:"linode_id"`
+		Label    NullableString `json:"label"`
+	}
+
+	for body, expected := range map[string]string{
+		`{"linode_id": null, "label": null}`:  `{"linode_id":null,"label":null}`,
+		`{"linode_id": 123, "label": "name"}`: `{"linode_id":123,"label":"name"}`,
+	} {
+		if err := json.Unmarshal([]byte(body), &decoded); err != nil {
+			t.Fatal(err)
+		}
+
+		if roundTrip, _ := json.Marshal(decoded); string(roundTrip) != expected {
+			t.Errorf("expected %s to round-trip, got %s", expected, roundTrip)
+		}
+	}
+
+	var value NullableInt
+	if err := json.Unmarshal([]byte(`"123"`), &value); err == nil {
+		t.Error("expected an error for a string value")
+	}
+
+	if ptr := NewNullableInt(&linodeID).Ptr(); ptr == nil || *ptr != linodeID {
+		t.Errorf("expected a pointer to %d, got %v", linodeID, ptr)
+	}
+
+	if ptr := NewNullableInt(nil).Ptr(); ptr != nil {
+		t.Errorf("expected a nil pointer, got %v", *ptr)
+	}
+}
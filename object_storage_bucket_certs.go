@@ -2,7 +2,8 @@ package linodego
 
 import (
 	"context"
-	"encoding/json"
+	"encoding/pem"
+	"errors"
 	"fmt"
 	"net/url"
 )
@@ -16,9 +17,28 @@ type ObjectStorageBucketCertUploadOptions struct {
 	PrivateKey  string `json:"private_key"`
 }
 
+// Validate checks that the certificate and private key are PEM encoded
+func (opts ObjectStorageBucketCertUploadOptions) Validate() error {
+	var errs []error
+
+	if block, _ := pem.Decode([]byte(opts.Certificate)); block == nil {
+		errs = append(errs, errors.New("certificate must be PEM encoded"))
+	}
+
+	if block, _ := pem.Decode([]byte(opts.PrivateKey)); block == nil {
+		errs = append(errs, errors.New("private key must be PEM encoded"))
+	}
+
+	return errors.Join(errs...)
+}
+
 // UploadObjectStorageBucketCert uploads a TLS/SSL Cert to be used with an Object Storage Bucket.
 func (c *Client) UploadObjectStorageBucketCert(ctx context.Context, clusterID, bucket string, opts ObjectStorageBucketCertUploadOptions) (*ObjectStorageBucketCert, error) {
-	body, err := json.Marshal(opts)
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+
+	body, err := c.marshalJSON(opts)
 	if err != nil {
 		return nil, err
 	}
@@ -15,6 +15,7 @@ import (
 type ObjectStorageBucket struct {
 	Label   string `json:"label"`
 	Cluster string `json:"cluster"`
+	Region  string `json:"region"`
 
 	Created  *time.Time `json:"-"`
 	Hostname string     `json:"hostname"`
@@ -50,7 +51,9 @@ func (i *ObjectStorageBucket) UnmarshalJSON(b []byte) error {
 
 // ObjectStorageBucketCreateOptions fields are those accepted by CreateObjectStorageBucket
 type ObjectStorageBucketCreateOptions struct {
-	Cluster string `json:"cluster"`
+	// Cluster or Region must be set. Newer regions are only available by Region.
+	Cluster string `json:"cluster,omitempty"`
+	Region  string `json:"region,omitempty"`
 	Label   string `json:"label"`
 
 	ACL         ObjectStorageACL `json:"acl,omitempty"`
@@ -74,6 +77,27 @@ const (
 	ACLPublicReadWrite   ObjectStorageACL = "public-read-write"
 )
 
+// validateObjectStorageACL checks that acl is empty or one of the known ACL types
+func validateObjectStorageACL(acl ObjectStorageACL) error {
+	switch acl {
+	case "", ACLPrivate, ACLPublicRead, ACLAuthenticatedRead, ACLPublicReadWrite:
+		return nil
+	default:
+		return fmt.Errorf("invalid acl %q, must be one of %q, %q, %q or %q",
+			acl, ACLPrivate, ACLPublicRead, ACLAuthenticatedRead, ACLPublicReadWrite)
+	}
+}
+
+// Validate checks that the ACL of the options is a known ACL type
+func (opts ObjectStorageBucketCreateOptions) Validate() error {
+	return validateObjectStorageACL(opts.ACL)
+}
+
+// Validate checks that the ACL of the options is a known ACL type
+func (opts ObjectStorageBucketUpdateAccessOptions) Validate() error {
+	return validateObjectStorageACL(opts.ACL)
+}
+
 // ObjectStorageBucketsPagedResponse represents a paginated ObjectStorageBucket API response
 type ObjectStorageBucketsPagedResponse struct {
 	*PageOptions
@@ -134,7 +158,11 @@ func (c *Client) GetObjectStorageBucket(ctx context.Context, clusterID, label st
 
 // CreateObjectStorageBucket creates an ObjectStorageBucket
 func (c *Client) CreateObjectStorageBucket(ctx context.Context, opts ObjectStorageBucketCreateOptions) (*ObjectStorageBucket, error) {
-	body, err := json.Marshal(opts)
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+
+	body, err := c.marshalJSON(opts)
 	if err != nil {
 		return nil, err
 	}
@@ -164,7 +192,11 @@ func (c *Client) GetObjectStorageBucketAccess(ctx context.Context, clusterID, la
 
 // UpdateObjectStorageBucketAccess updates the access configuration for an ObjectStorageBucket
 func (c *Client) UpdateObjectStorageBucketAccess(ctx context.Context, clusterID, label string, opts ObjectStorageBucketUpdateAccessOptions) error {
-	body, err := json.Marshal(opts)
+	if err := opts.Validate(); err != nil {
+		return err
+	}
+
+	body, err := c.marshalJSON(opts)
 	if err != nil {
 		return err
 	}
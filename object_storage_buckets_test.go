@@ -0,0 +1,45 @@
+package linodego
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestClient_UpdateObjectStorageBucketAccess(t *testing.T) {
+	ts, client := createTestServer(http.MethodPost, "/v4/object-storage/buckets/us-east-1/example/access", "application/json", `{}`, http.StatusOK)
+	defer ts.Close()
+
+	corsEnabled := true
+
+	if err := client.UpdateObjectStorageBucketAccess(context.Background(), "us-east-1", "example", ObjectStorageBucketUpdateAccessOptions{
+		ACL:         ACLPublicRead,
+		CorsEnabled: &corsEnabled,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	err := client.UpdateObjectStorageBucketAccess(context.Background(), "us-east-1", "example", ObjectStorageBucketUpdateAccessOptions{ACL: "public"})
+	if err == nil || !strings.Contains(err.Error(), `invalid acl "public"`) {
+		t.Errorf("expected an error for an unknown ACL, got %v", err)
+	}
+
+	if _, err := client.CreateObjectStorageBucket(context.Background(), ObjectStorageBucketCreateOptions{
+		Cluster: "us-east-1", Label: "example", ACL: "public",
+	}); err == nil {
+		t.Error("expected an error for an unknown ACL")
+	}
+}
+
+func TestObjectStorageBucketCertUploadOptions_Validate(t *testing.T) {
+	opts := ObjectStorageBucketCertUploadOptions{
+		Certificate: "-----BEGIN CERTIFICATE-----\nMIIB\n-----END CERTIFICATE-----\n",
+		PrivateKey:  "not a key",
+	}
+
+	err := opts.Validate()
+	if err == nil || strings.Contains(err.Error(), "certificate") || !strings.Contains(err.Error(), "private key") {
+		t.Errorf("expected an error for the private key only, got %v", err)
+	}
+}
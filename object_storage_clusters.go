@@ -8,13 +8,22 @@ import (
 	"github.com/go-resty/resty/v2"
 )
 
+// ObjectStorageClusterStatus is the status of an ObjectStorageCluster
+type ObjectStorageClusterStatus string
+
+// ObjectStorageClusterStatus constants reflect whether an ObjectStorageCluster can be used
+const (
+	ObjectStorageClusterAvailable   ObjectStorageClusterStatus = "available"
+	ObjectStorageClusterUnavailable ObjectStorageClusterStatus = "unavailable"
+)
+
 // ObjectStorageCluster represents a linode object storage cluster object
 type ObjectStorageCluster struct {
-	ID               string `json:"id"`
-	Domain           string `json:"domain"`
-	Status           string `json:"status"`
-	Region           string `json:"region"`
-	StaticSiteDomain string `json:"static_site_domain"`
+	ID               string                     `json:"id"`
+	Domain           string                     `json:"domain"`
+	Status           ObjectStorageClusterStatus `json:"status"`
+	Region           string                     `json:"region"`
+	StaticSiteDomain string                     `json:"static_site_domain"`
 }
 
 // ObjectStorageClustersPagedResponse represents a linode API response for listing
@@ -0,0 +1,257 @@
+package linodego
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// DefaultDownloadPartSize is the size of the ranges requested by DownloadObject when
+	// DownloadOptions.PartSize is not set.
+	DefaultDownloadPartSize = 16 * 1024 * 1024
+
+	// DefaultDownloadRetries is the number of times a range is retried when
+	// DownloadOptions.MaxRetries is not set.
+	DefaultDownloadRetries = 3
+
+	// downloadRetryMaxWaitTime bounds the backoff between retries of a range
+	downloadRetryMaxWaitTime = 30 * time.Second
+)
+
+// DownloadOptions are the options accepted by DownloadObject
+type DownloadOptions struct {
+	// AccessKey and SecretKey of an Object Storage key, see CreateObjectStorageKey
+	AccessKey string
+	SecretKey string
+
+	// Cluster is the Object Storage cluster of the bucket, e.g. "us-east-1".
+	// It is used as the signing region and to determine the endpoint.
+	Cluster string
+
+	// Region is the region of a bucket that is not in a cluster, e.g. "us-mia".
+	// It is used as the signing region instead of Cluster and is resolved to
+	// an Endpoint with Client.ResolveObjectStorageHost.
+	Region string
+
+	// Endpoint overrides the host of the cluster, e.g. "us-east-1.linodeobjects.com".
+	// A URL including the scheme may be used.
+	Endpoint string
+
+	// Offset is the byte offset to start downloading from, e.g. the offset returned
+	// by a previous DownloadObject call that failed. The bytes before it are not written.
+	Offset int64
+
+	// PartSize is the size of each requested range. Defaults to DefaultDownloadPartSize.
+	PartSize int64
+
+	// MaxRetries is the number of times a range is retried after a connection failure or a
+	// retryable response. Defaults to DefaultDownloadRetries, a negative value disables retries.
+	MaxRetries int
+
+	// RetryWaitTime is the initial backoff between retries of a range, which grows exponentially.
+	// Defaults to one second.
+	RetryWaitTime time.Duration
+
+	// OnProgress is called after each downloaded range with the number of bytes of the
+	// object written so far, including Offset, and the size of the object.
+	OnProgress func(written, total int64)
+
+	// HTTPClient is used to make requests to the cluster. Defaults to http.DefaultClient.
+	// This should not be the client authenticated against the Linode API.
+	HTTPClient *http.Client
+}
+
+// DownloadObject downloads the given object to w using S3-compatible ranged requests of
+// opts.PartSize, starting at opts.Offset. Each range is written to w at its offset in the object
+// and retried on connection failures like requests to the Linode API. It returns the offset up to
+// which the object was written, which can be passed as opts.Offset to resume a failed download.
+// Use Client.DownloadObject to resolve the endpoint of a region automatically.
+func DownloadObject(ctx context.Context, bucket, object string, w io.WriterAt, opts DownloadOptions) (int64, error) {
+	if opts.AccessKey == "" || opts.SecretKey == "" {
+		return opts.Offset, errors.New("an access key and secret key are required to download objects")
+	}
+
+	if opts.Cluster == "" && opts.Region == "" {
+		return opts.Offset, errors.New("a cluster or region is required to download objects")
+	}
+
+	if opts.Region != "" {
+		if opts.Endpoint == "" {
+			return opts.Offset, errors.New("an endpoint is required to download objects from a region, see Client.ResolveObjectStorageHost")
+		}
+
+		opts.Cluster = opts.Region
+	}
+
+	if opts.Offset < 0 {
+		return 0, fmt.Errorf("offset %d must not be negative", opts.Offset)
+	}
+
+	if opts.PartSize <= 0 {
+		opts.PartSize = DefaultDownloadPartSize
+	}
+
+	if opts.MaxRetries == 0 {
+		opts.MaxRetries = DefaultDownloadRetries
+	}
+
+	if opts.RetryWaitTime <= 0 {
+		opts.RetryWaitTime = time.Second
+	}
+
+	if opts.Endpoint == "" {
+		opts.Endpoint = objectStorageClusterHost(opts.Cluster)
+	}
+
+	objectURL, err := objectStorageObjectURL(opts.Endpoint, bucket, object)
+	if err != nil {
+		return opts.Offset, err
+	}
+
+	// The uploader signs the requests to the object
+	u := objectStorageUploader{
+		opts:       UploadOptions{AccessKey: opts.AccessKey, SecretKey: opts.SecretKey, Cluster: opts.Cluster},
+		httpClient: opts.HTTPClient,
+		objectURL:  objectURL,
+	}
+	if u.httpClient == nil {
+		u.httpClient = http.DefaultClient
+	}
+
+	backoff := exponentialBackoff(opts.RetryWaitTime, downloadRetryMaxWaitTime)
+	offset, total := opts.Offset, int64(-1)
+
+	for total < 0 || offset < total {
+		data, size, err := downloadRangeWithRetries(ctx, &u, offset, opts.PartSize, opts.MaxRetries, backoff)
+		if err != nil {
+			return offset, err
+		}
+
+		if size < 0 {
+			// The range starts at or after the end of the object
+			break
+		}
+
+		total = size
+
+		if _, err := w.WriteAt(data, offset); err != nil {
+			return offset, fmt.Errorf("failed to write range at offset %d: %w", offset, err)
+		}
+
+		offset += int64(len(data))
+
+		if opts.OnProgress != nil {
+			opts.OnProgress(offset, total)
+		}
+
+		if len(data) == 0 {
+			break
+		}
+	}
+
+	return offset, nil
+}
+
+// DownloadObject downloads the given object to w like the DownloadObject function.
+// If opts.Region is set and opts.Endpoint is not, the endpoint is resolved using ResolveObjectStorageHost.
+func (c *Client) DownloadObject(ctx context.Context, bucket, object string, w io.WriterAt, opts DownloadOptions) (int64, error) {
+	if opts.Region != "" && opts.Endpoint == "" {
+		endpoint, err := c.ResolveObjectStorageHost(ctx, opts.Region)
+		if err != nil {
+			return opts.Offset, err
+		}
+
+		opts.Endpoint = endpoint
+	}
+
+	return DownloadObject(ctx, bucket, object, w, opts)
+}
+
+// downloadRangeWithRetries downloads a range, retrying it at most maxRetries times
+// when the request fails with a connection failure or a retryable status code.
+func downloadRangeWithRetries(
+	ctx context.Context, u *objectStorageUploader, offset, size int64, maxRetries int, backoff RetryBackoff,
+) ([]byte, int64, error) {
+	for attempt := 0; ; attempt++ {
+		data, total, err := downloadRange(ctx, u, offset, size)
+		if err == nil || attempt >= maxRetries || ctx.Err() != nil || !downloadRetryCondition(err) {
+			return data, total, err
+		}
+
+		select {
+		case <-time.After(backoff(attempt)):
+		case <-ctx.Done():
+			return nil, 0, fmt.Errorf("failed to download range at offset %d: %w", offset, ctx.Err())
+		}
+	}
+}
+
+// downloadRetryCondition reports whether a failed range request should be retried
+func downloadRetryCondition(err error) bool {
+	var objErr objectStorageError
+	if errors.As(err, &objErr) {
+		switch objErr.StatusCode {
+		case http.StatusRequestTimeout, http.StatusTooManyRequests, http.StatusInternalServerError,
+			http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+			return true
+		}
+
+		return false
+	}
+
+	return connectionFailureRetryCondition(nil, err)
+}
+
+// downloadRange downloads up to size bytes of the object starting at offset and returns
+// them along with the size of the object, which is -1 if offset is past the end of the object.
+func downloadRange(ctx context.Context, u *objectStorageUploader, offset, size int64) ([]byte, int64, error) {
+	headers := http.Header{}
+	headers.Set("Range", fmt.Sprintf("bytes=%d-%d", offset, offset+size-1))
+
+	var (
+		status       int
+		contentRange string
+	)
+
+	data, err := u.doWithResponse(ctx, http.MethodGet, nil, headers, nil, func(resp *http.Response) {
+		status = resp.StatusCode
+		contentRange = resp.Header.Get("Content-Range")
+	})
+	if err != nil {
+		var objErr objectStorageError
+		if errors.As(err, &objErr) && objErr.StatusCode == http.StatusRequestedRangeNotSatisfiable {
+			return nil, -1, nil
+		}
+
+		return nil, 0, fmt.Errorf("failed to download range at offset %d: %w", offset, err)
+	}
+
+	if status != http.StatusPartialContent {
+		// The range was ignored and the whole object was returned
+		total := int64(len(data))
+		if offset >= total {
+			return nil, -1, nil
+		}
+
+		return data[offset:], total, nil
+	}
+
+	// Content-Range: bytes <start>-<end>/<total>
+	_, totalStr, found := strings.Cut(contentRange, "/")
+	if !found || totalStr == "*" {
+		return nil, 0, fmt.Errorf("unexpected Content-Range %q", contentRange)
+	}
+
+	total, err := strconv.ParseInt(totalStr, 10, 64)
+	if err != nil {
+		return nil, 0, fmt.Errorf("unexpected Content-Range %q: %w", contentRange, err)
+	}
+
+	return data, total, nil
+}
@@ -0,0 +1,149 @@
+package linodego
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeObjectStorageObject serves ranges of an object, failing the requests for the ranges in failures
+type fakeObjectStorageObject struct {
+	mu       sync.Mutex
+	data     []byte
+	failures map[string]int
+	ranges   []string
+}
+
+func (s *fakeObjectStorageObject) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !strings.HasPrefix(r.Header.Get("Authorization"), sigV4Algorithm+" Credential=access/") || r.URL.Path != "/bucket/large.bin" {
+		rw.WriteHeader(http.StatusForbidden)
+		return
+	}
+
+	requested := r.Header.Get("Range")
+	s.ranges = append(s.ranges, requested)
+
+	if s.failures[requested] > 0 {
+		s.failures[requested]--
+		rw.WriteHeader(http.StatusServiceUnavailable)
+		rw.Write([]byte(`<Error><Code>SlowDown</Code><Message>try again</Message></Error>`))
+
+		return
+	}
+
+	var start, end int
+	fmt.Sscanf(requested, "bytes=%d-%d", &start, &end)
+
+	if start >= len(s.data) {
+		rw.WriteHeader(http.StatusRequestedRangeNotSatisfiable)
+		return
+	}
+
+	if end >= len(s.data) {
+		end = len(s.data) - 1
+	}
+
+	rw.Header().Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", start, end, len(s.data)))
+	rw.WriteHeader(http.StatusPartialContent)
+	rw.Write(s.data[start : end+1])
+}
+
+// writerAtBuffer is an in-memory io.WriterAt
+type writerAtBuffer struct {
+	data []byte
+}
+
+func (b *writerAtBuffer) WriteAt(p []byte, off int64) (int, error) {
+	if end := int(off) + len(p); end > len(b.data) {
+		b.data = append(b.data, make([]byte, end-len(b.data))...)
+	}
+
+	return copy(b.data[off:], p), nil
+}
+
+func TestDownloadObject(t *testing.T) {
+	storage := &fakeObjectStorageObject{
+		data:     bytes.Repeat([]byte("0123456789"), 25),
+		failures: map[string]int{"bytes=100-199": 2},
+	}
+	ts := httptest.NewServer(storage)
+	defer ts.Close()
+
+	var progress []int64
+	w := &writerAtBuffer{}
+
+	opts := DownloadOptions{
+		AccessKey:     "access",
+		SecretKey:     "secret",
+		Cluster:       "us-east-1",
+		Endpoint:      ts.URL,
+		PartSize:      100,
+		RetryWaitTime: time.Millisecond,
+		OnProgress: func(written, total int64) {
+			if total != 250 {
+				t.Errorf("unexpected total %d", total)
+			}
+
+			progress = append(progress, written)
+		},
+	}
+
+	written, err := DownloadObject(context.Background(), "bucket", "large.bin", w, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if written != 250 || !bytes.Equal(w.data, storage.data) {
+		t.Errorf("expected the downloaded object to match, got %d bytes", written)
+	}
+
+	if fmt.Sprint(progress) != "[100 200 250]" || len(storage.ranges) != 5 {
+		t.Errorf("unexpected progress %v after requesting %v", progress, storage.ranges)
+	}
+
+	// A failed download is resumed from the returned offset
+	storage.failures["bytes=200-299"] = 2
+	opts.MaxRetries = 1
+	opts.OnProgress = nil
+	w = &writerAtBuffer{}
+
+	written, err = DownloadObject(context.Background(), "bucket", "large.bin", w, opts)
+	if err == nil || !strings.Contains(err.Error(), "SlowDown") || written != 200 {
+		t.Fatalf("expected the download to fail at offset 200, got %d and %v", written, err)
+	}
+
+	opts.Offset = written
+
+	written, err = DownloadObject(context.Background(), "bucket", "large.bin", w, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if written != 250 || !bytes.Equal(w.data, storage.data) {
+		t.Errorf("expected the resumed object to match, got %d bytes", written)
+	}
+}
+
+func TestDownloadObject_Empty(t *testing.T) {
+	ts := httptest.NewServer(&fakeObjectStorageObject{})
+	defer ts.Close()
+
+	written, err := DownloadObject(context.Background(), "bucket", "large.bin", &writerAtBuffer{}, DownloadOptions{
+		AccessKey: "access",
+		SecretKey: "secret",
+		Cluster:   "us-east-1",
+		Endpoint:  ts.URL,
+	})
+	if err != nil || written != 0 {
+		t.Errorf("expected an empty download, got %d and %v", written, err)
+	}
+}
@@ -0,0 +1,122 @@
+package linodego
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// ObjectStorageEndpointType constants start with ObjectStorageEndpoint and include all known Object Storage endpoint types.
+type ObjectStorageEndpointType string
+
+// ObjectStorageEndpointType constants represent the generation of an Object Storage endpoint.
+const (
+	ObjectStorageEndpointE0 ObjectStorageEndpointType = "E0"
+	ObjectStorageEndpointE1 ObjectStorageEndpointType = "E1"
+	ObjectStorageEndpointE2 ObjectStorageEndpointType = "E2"
+	ObjectStorageEndpointE3 ObjectStorageEndpointType = "E3"
+)
+
+// ObjectStorageEndpoint represents an S3 endpoint serving the Object Storage of a region
+type ObjectStorageEndpoint struct {
+	Region       string                    `json:"region"`
+	S3Endpoint   *string                   `json:"s3_endpoint"`
+	EndpointType ObjectStorageEndpointType `json:"endpoint_type"`
+}
+
+// ObjectStorageEndpointsPagedResponse represents a linode API response for listing
+type ObjectStorageEndpointsPagedResponse struct {
+	*PageOptions
+	Data []ObjectStorageEndpoint `json:"data"`
+}
+
+// objectStorageEndpointCache holds the Object Storage endpoints fetched by a Client and its copies.
+type objectStorageEndpointCache struct {
+	mu        sync.Mutex
+	endpoints []ObjectStorageEndpoint
+}
+
+// endpoint gets the endpoint URL for ObjectStorageEndpoint
+func (ObjectStorageEndpointsPagedResponse) endpoint(_ ...any) string {
+	return "object-storage/endpoints"
+}
+
+func (resp *ObjectStorageEndpointsPagedResponse) castResult(r *resty.Request, e string) (int, int, error) {
+	res, err := coupleAPIErrors(r.SetResult(ObjectStorageEndpointsPagedResponse{}).Get(e))
+	if err != nil {
+		return 0, 0, err
+	}
+	castedRes := res.Result().(*ObjectStorageEndpointsPagedResponse)
+	resp.Data = append(resp.Data, castedRes.Data...)
+	return castedRes.Pages, castedRes.Results, nil
+}
+
+// GetObjectStorageEndpoints gets the S3 endpoints of all regions offering Object Storage.
+// The endpoints rarely change, so they are fetched once and cached for the lifetime of the Client.
+func (c *Client) GetObjectStorageEndpoints(ctx context.Context) ([]ObjectStorageEndpoint, error) {
+	if c.objectStorageEndpoints == nil {
+		return c.listObjectStorageEndpoints(ctx)
+	}
+
+	c.objectStorageEndpoints.mu.Lock()
+	defer c.objectStorageEndpoints.mu.Unlock()
+
+	if c.objectStorageEndpoints.endpoints == nil {
+		endpoints, err := c.listObjectStorageEndpoints(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		c.objectStorageEndpoints.endpoints = endpoints
+	}
+
+	return append([]ObjectStorageEndpoint(nil), c.objectStorageEndpoints.endpoints...), nil
+}
+
+func (c *Client) listObjectStorageEndpoints(ctx context.Context) ([]ObjectStorageEndpoint, error) {
+	response := ObjectStorageEndpointsPagedResponse{}
+	err := c.listHelper(ctx, &response, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Distinguish an empty list from one that has not been fetched
+	if response.Data == nil {
+		response.Data = []ObjectStorageEndpoint{}
+	}
+
+	return response.Data, nil
+}
+
+// ResolveObjectStorageHost returns the S3 host serving the Object Storage of the given region,
+// e.g. "us-mia-1.linodeobjects.com", for use with UploadObject and SignObjectURL.
+// If a region is served by several endpoints, the newest endpoint type is preferred.
+func (c *Client) ResolveObjectStorageHost(ctx context.Context, region string) (string, error) {
+	endpoints, err := c.GetObjectStorageEndpoints(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to get Object Storage endpoints: %w", err)
+	}
+
+	var resolved *ObjectStorageEndpoint
+
+	for i, endpoint := range endpoints {
+		if endpoint.Region != region || endpoint.S3Endpoint == nil || *endpoint.S3Endpoint == "" {
+			continue
+		}
+
+		if resolved == nil || endpoint.EndpointType > resolved.EndpointType {
+			resolved = &endpoints[i]
+		}
+	}
+
+	if resolved == nil {
+		return "", fmt.Errorf("no Object Storage endpoint found for region %q", region)
+	}
+
+	host := strings.TrimPrefix(*resolved.S3Endpoint, "https://")
+
+	return strings.TrimSuffix(host, "/"), nil
+}
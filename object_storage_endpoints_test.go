@@ -0,0 +1,57 @@
+package linodego
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestClient_ResolveObjectStorageHost(t *testing.T) {
+	var requests int
+
+	ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v4/object-storage/endpoints" {
+			rw.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		requests++
+
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(`{"data": [
+			{"region": "us-east", "endpoint_type": "E0", "s3_endpoint": "us-east-1.linodeobjects.com"},
+			{"region": "us-mia", "endpoint_type": "E1", "s3_endpoint": "us-mia-1.linodeobjects.com"},
+			{"region": "us-mia", "endpoint_type": "E3", "s3_endpoint": "https://us-mia-1.e3.linodeobjects.com/"},
+			{"region": "us-ord", "endpoint_type": "E2", "s3_endpoint": null}
+		], "page": 1, "pages": 1, "results": 4}`))
+	}))
+	defer ts.Close()
+
+	client := NewClient(nil)
+	client.SetBaseURL(ts.URL)
+
+	tests := map[string]string{
+		"us-east": "us-east-1.linodeobjects.com",
+		"us-mia":  "us-mia-1.e3.linodeobjects.com",
+	}
+
+	for region, expected := range tests {
+		host, err := client.ResolveObjectStorageHost(context.Background(), region)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if host != expected {
+			t.Errorf("expected %s to resolve to %s, got %s", region, expected, host)
+		}
+	}
+
+	if _, err := client.ResolveObjectStorageHost(context.Background(), "us-ord"); err == nil {
+		t.Error("expected an error for a region without an S3 endpoint")
+	}
+
+	if requests != 1 {
+		t.Errorf("expected the endpoints to be fetched once, got %d requests", requests)
+	}
+}
@@ -2,7 +2,6 @@ package linodego
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 
 	"github.com/go-resty/resty/v2"
@@ -16,11 +15,20 @@ type ObjectStorageKey struct {
 	SecretKey    string                          `json:"secret_key"`
 	Limited      bool                            `json:"limited"`
 	BucketAccess *[]ObjectStorageKeyBucketAccess `json:"bucket_access"`
+	Regions      []ObjectStorageKeyRegion        `json:"regions"`
+}
+
+// ObjectStorageKeyRegion represents a region an object storage key is valid in and the S3 endpoint serving it
+type ObjectStorageKeyRegion struct {
+	ID         string `json:"id"`
+	S3Endpoint string `json:"s3_endpoint"`
 }
 
 // ObjectStorageKeyBucketAccess represents a linode limited object storage key's bucket access
 type ObjectStorageKeyBucketAccess struct {
-	Cluster     string `json:"cluster"`
+	// Cluster or Region must be set. Newer regions are only available by Region.
+	Cluster     string `json:"cluster,omitempty"`
+	Region      string `json:"region,omitempty"`
 	BucketName  string `json:"bucket_name"`
 	Permissions string `json:"permissions"`
 }
@@ -29,6 +37,7 @@ type ObjectStorageKeyBucketAccess struct {
 type ObjectStorageKeyCreateOptions struct {
 	Label        string                          `json:"label"`
 	BucketAccess *[]ObjectStorageKeyBucketAccess `json:"bucket_access"`
+	Regions      []string                        `json:"regions,omitempty"`
 }
 
 // ObjectStorageKeyUpdateOptions fields are those accepted by UpdateObjectStorageKey
@@ -69,7 +78,7 @@ func (c *Client) ListObjectStorageKeys(ctx context.Context, opts *ListOptions) (
 
 // CreateObjectStorageKey creates a ObjectStorageKey
 func (c *Client) CreateObjectStorageKey(ctx context.Context, opts ObjectStorageKeyCreateOptions) (*ObjectStorageKey, error) {
-	body, err := json.Marshal(opts)
+	body, err := c.marshalJSON(opts)
 	if err != nil {
 		return nil, NewError(err)
 	}
@@ -96,7 +105,7 @@ func (c *Client) GetObjectStorageKey(ctx context.Context, keyID int) (*ObjectSto
 
 // UpdateObjectStorageKey updates the object storage key with the specified id
 func (c *Client) UpdateObjectStorageKey(ctx context.Context, keyID int, opts ObjectStorageKeyUpdateOptions) (*ObjectStorageKey, error) {
-	body, err := json.Marshal(opts)
+	body, err := c.marshalJSON(opts)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,283 @@
+package linodego
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	sigV4Algorithm      = "AWS4-HMAC-SHA256"
+	sigV4Service        = "s3"
+	sigV4DateFormat     = "20060102"
+	sigV4DateTimeFormat = "20060102T150405Z"
+
+	sigV4UnsignedPayload = "UNSIGNED-PAYLOAD"
+
+	// MaxObjectURLExpiry is the longest expiry accepted for presigned object URLs
+	MaxObjectURLExpiry = 7 * 24 * time.Hour
+)
+
+// SignObjectURLOptions are optional parameters for SignObjectURL
+type SignObjectURLOptions struct {
+	// ResponseContentType overrides the Content-Type of the response to a GET request
+	ResponseContentType string
+
+	// Endpoint overrides the host of the cluster, e.g. the host of a region returned by
+	// Client.ResolveObjectStorageHost. The cluster is then only used as the signing region.
+	Endpoint string
+}
+
+// SignObjectURL returns a presigned URL for the given object on an Object Storage cluster,
+// allowing it to be downloaded (GET) or uploaded (PUT) without credentials until expiry.
+// The URL is signed locally with the access and secret key of an Object Storage key
+// (see CreateObjectStorageKey) and no request is made to the API.
+func SignObjectURL(
+	accessKey, secretKey, cluster, bucket, object, method string, expiry time.Duration, opts ...SignObjectURLOptions,
+) (string, error) {
+	method = strings.ToUpper(method)
+	if method != http.MethodGet && method != http.MethodPut {
+		return "", fmt.Errorf("unsupported method %q, must be GET or PUT", method)
+	}
+
+	if expiry < time.Second || expiry > MaxObjectURLExpiry {
+		return "", fmt.Errorf("expiry must be between 1 second and %s", MaxObjectURLExpiry)
+	}
+
+	if accessKey == "" || secretKey == "" {
+		return "", errors.New("an access key and secret key are required to sign object URLs")
+	}
+
+	if cluster == "" || bucket == "" || object == "" {
+		return "", errors.New("a cluster, bucket and object are required to sign object URLs")
+	}
+
+	endpoint := objectStorageClusterHost(cluster)
+	query := url.Values{}
+
+	for _, o := range opts {
+		if o.ResponseContentType != "" {
+			query.Set("response-content-type", o.ResponseContentType)
+		}
+
+		if o.Endpoint != "" {
+			endpoint = o.Endpoint
+		}
+	}
+
+	u, err := objectStorageObjectURL(endpoint, bucket, object)
+	if err != nil {
+		return "", err
+	}
+
+	u.RawQuery = query.Encode()
+
+	return presignURLV4(method, u, accessKey, secretKey, cluster, expiry, time.Now()), nil
+}
+
+// objectStorageEndpointURL returns the base URL of an Object Storage endpoint,
+// which is either a host or a URL including the scheme.
+func objectStorageEndpointURL(endpoint string) string {
+	if strings.Contains(endpoint, "://") {
+		return strings.TrimSuffix(endpoint, "/")
+	}
+
+	return "https://" + endpoint
+}
+
+// objectStorageClusterHost returns the S3 host of an Object Storage cluster
+func objectStorageClusterHost(cluster string) string {
+	return fmt.Sprintf("%s.linodeobjects.com", cluster)
+}
+
+// objectStorageObjectURL returns the path-style URL of an object
+func objectStorageObjectURL(endpoint, bucket, object string) (*url.URL, error) {
+	u, err := url.Parse(objectStorageEndpointURL(endpoint))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse Object Storage endpoint: %w", err)
+	}
+
+	segments := strings.Split(bucket+"/"+strings.TrimPrefix(object, "/"), "/")
+	escaped := make([]string, len(segments))
+
+	for i, segment := range segments {
+		escaped[i] = sigV4Escape(segment)
+	}
+
+	// Escaping the path the same way as it is signed keeps the signature valid for any key
+	u.Path = "/" + strings.Join(segments, "/")
+	u.RawPath = "/" + strings.Join(escaped, "/")
+
+	return u, nil
+}
+
+// signRequestV4 signs req with AWS Signature Version 4 using the Authorization header.
+// All headers set on req at the time of signing are signed along with the host.
+func signRequestV4(req *http.Request, accessKey, secretKey, region, payloadHash string, now time.Time) {
+	now = now.UTC()
+
+	req.Header.Set("X-Amz-Date", now.Format(sigV4DateTimeFormat))
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	headers := map[string]string{"host": req.URL.Host}
+	for name, values := range req.Header {
+		headers[strings.ToLower(name)] = strings.TrimSpace(strings.Join(values, ","))
+	}
+
+	canonicalHeaders, signedHeaders := sigV4CanonicalHeaders(headers)
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		sigV4CanonicalURI(req.URL),
+		sigV4CanonicalQuery(req.URL.Query()),
+		canonicalHeaders,
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := sigV4Scope(now, region)
+	signature := sigV4Signature(secretKey, region, now, scope, canonicalRequest)
+
+	req.Header.Set("Authorization", fmt.Sprintf(
+		"%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		sigV4Algorithm, accessKey, scope, signedHeaders, signature,
+	))
+}
+
+// presignURLV4 adds an AWS Signature Version 4 to the query of u, valid for expiry.
+// Only the host header is signed and the payload is left unsigned.
+func presignURLV4(method string, u *url.URL, accessKey, secretKey, region string, expiry time.Duration, now time.Time) string {
+	now = now.UTC()
+	scope := sigV4Scope(now, region)
+
+	query := u.Query()
+	query.Set("X-Amz-Algorithm", sigV4Algorithm)
+	query.Set("X-Amz-Credential", accessKey+"/"+scope)
+	query.Set("X-Amz-Date", now.Format(sigV4DateTimeFormat))
+	query.Set("X-Amz-Expires", fmt.Sprintf("%d", int64(expiry.Seconds())))
+	query.Set("X-Amz-SignedHeaders", "host")
+
+	canonicalHeaders, signedHeaders := sigV4CanonicalHeaders(map[string]string{"host": u.Host})
+	canonicalRequest := strings.Join([]string{
+		method,
+		sigV4CanonicalURI(u),
+		sigV4CanonicalQuery(query),
+		canonicalHeaders,
+		signedHeaders,
+		sigV4UnsignedPayload,
+	}, "\n")
+
+	signature := sigV4Signature(secretKey, region, now, scope, canonicalRequest)
+
+	signed := *u
+	signed.RawQuery = sigV4CanonicalQuery(query) + "&X-Amz-Signature=" + signature
+
+	return signed.String()
+}
+
+func sigV4Scope(now time.Time, region string) string {
+	return fmt.Sprintf("%s/%s/%s/aws4_request", now.Format(sigV4DateFormat), region, sigV4Service)
+}
+
+func sigV4Signature(secretKey, region string, now time.Time, scope, canonicalRequest string) string {
+	requestHash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := strings.Join([]string{
+		sigV4Algorithm,
+		now.Format(sigV4DateTimeFormat),
+		scope,
+		hex.EncodeToString(requestHash[:]),
+	}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+secretKey), now.Format(sigV4DateFormat))
+	key = hmacSHA256(key, region)
+	key = hmacSHA256(key, sigV4Service)
+	key = hmacSHA256(key, "aws4_request")
+
+	return hex.EncodeToString(hmacSHA256(key, stringToSign))
+}
+
+func sigV4CanonicalHeaders(headers map[string]string) (string, string) {
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	var canonical strings.Builder
+	for _, name := range names {
+		canonical.WriteString(name + ":" + headers[name] + "\n")
+	}
+
+	return canonical.String(), strings.Join(names, ";")
+}
+
+func sigV4CanonicalURI(u *url.URL) string {
+	path := u.EscapedPath()
+	if path == "" {
+		return "/"
+	}
+
+	// Re-escape every segment using the stricter S3 rules
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		if unescaped, err := url.PathUnescape(segment); err == nil {
+			segments[i] = sigV4Escape(unescaped)
+		}
+	}
+
+	return strings.Join(segments, "/")
+}
+
+func sigV4CanonicalQuery(query url.Values) string {
+	keys := make([]string, 0, len(query))
+	for key := range query {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	pairs := make([]string, 0, len(keys))
+	for _, key := range keys {
+		values := append([]string(nil), query[key]...)
+		sort.Strings(values)
+
+		for _, value := range values {
+			pairs = append(pairs, sigV4Escape(key)+"="+sigV4Escape(value))
+		}
+	}
+
+	return strings.Join(pairs, "&")
+}
+
+// sigV4Escape percent-encodes every byte except the unreserved characters of RFC 3986
+func sigV4Escape(s string) string {
+	var escaped strings.Builder
+
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+
+		if ('A' <= c && c <= 'Z') || ('a' <= c && c <= 'z') || ('0' <= c && c <= '9') ||
+			c == '-' || c == '_' || c == '.' || c == '~' {
+			escaped.WriteByte(c)
+			continue
+		}
+
+		fmt.Fprintf(&escaped, "%%%02X", c)
+	}
+
+	return escaped.String()
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+
+	return h.Sum(nil)
+}
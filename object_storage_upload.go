@@ -0,0 +1,400 @@
+package linodego
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultUploadPartSize is the size of the parts of a multipart upload when
+	// UploadOptions.PartSize is not set.
+	DefaultUploadPartSize = 16 * 1024 * 1024
+
+	// MinUploadPartSize is the minimum size of all but the last part of a multipart upload.
+	MinUploadPartSize = 5 * 1024 * 1024
+
+	// DefaultUploadConcurrency is the number of parts uploaded concurrently when
+	// UploadOptions.Concurrency is not set.
+	DefaultUploadConcurrency = 4
+
+	// uploadAbortTimeout bounds the time spent aborting a failed multipart upload
+	uploadAbortTimeout = 30 * time.Second
+)
+
+// UploadOptions are the options accepted by UploadObject
+type UploadOptions struct {
+	// AccessKey and SecretKey of an Object Storage key, see CreateObjectStorageKey
+	AccessKey string
+	SecretKey string
+
+	// Cluster is the Object Storage cluster of the bucket, e.g. "us-east-1".
+	// It is used as the signing region and to determine the endpoint.
+	Cluster string
+
+	// Region is the region of a bucket that is not in a cluster, e.g. "us-mia".
+	// It is used as the signing region instead of Cluster and is resolved to
+	// an Endpoint with Client.ResolveObjectStorageHost.
+	Region string
+
+	// Endpoint overrides the host of the cluster, e.g. "us-east-1.linodeobjects.com".
+	// A URL including the scheme may be used.
+	Endpoint string
+
+	// PartSize is the size of each uploaded part. Defaults to DefaultUploadPartSize
+	// and must be at least MinUploadPartSize.
+	PartSize int64
+
+	// Concurrency is the maximum number of parts uploaded concurrently.
+	// Defaults to DefaultUploadConcurrency.
+	Concurrency int
+
+	// ContentType of the object
+	ContentType string
+
+	// HTTPClient is used to make requests to the cluster. Defaults to http.DefaultClient.
+	// This should not be the client authenticated against the Linode API.
+	HTTPClient *http.Client
+}
+
+type objectStorageUploader struct {
+	opts       UploadOptions
+	httpClient *http.Client
+	objectURL  *url.URL
+}
+
+type initiateMultipartUploadResult struct {
+	UploadID string `xml:"UploadId"`
+}
+
+type completedPart struct {
+	PartNumber int    `xml:"PartNumber"`
+	ETag       string `xml:"ETag"`
+}
+
+type completeMultipartUpload struct {
+	XMLName xml.Name        `xml:"CompleteMultipartUpload"`
+	Parts   []completedPart `xml:"Part"`
+}
+
+type completeMultipartUploadResult struct {
+	ETag string `xml:"ETag"`
+}
+
+type objectStorageError struct {
+	StatusCode int    `xml:"-"`
+	Code       string `xml:"Code"`
+	Message    string `xml:"Message"`
+}
+
+func (e objectStorageError) Error() string {
+	if e.Code == "" {
+		return fmt.Sprintf("[%d] %s", e.StatusCode, http.StatusText(e.StatusCode))
+	}
+
+	return fmt.Sprintf("[%d] %s: %s", e.StatusCode, e.Code, e.Message)
+}
+
+// UploadObject uploads the contents of r to the given object using an S3-compatible
+// multipart upload, reading r in parts of opts.PartSize and uploading them concurrently.
+// It returns the ETag of the uploaded object. If the upload fails or ctx is cancelled,
+// the multipart upload is aborted so that no incomplete upload is left behind.
+// Use Client.UploadObject to resolve the endpoint of a region automatically.
+func UploadObject(ctx context.Context, bucket, object string, r io.Reader, opts UploadOptions) (string, error) {
+	if opts.AccessKey == "" || opts.SecretKey == "" {
+		return "", errors.New("an access key and secret key are required to upload objects")
+	}
+
+	if opts.Cluster == "" && opts.Region == "" {
+		return "", errors.New("a cluster or region is required to upload objects")
+	}
+
+	if opts.Region != "" {
+		if opts.Endpoint == "" {
+			return "", errors.New("an endpoint is required to upload objects to a region, see Client.ResolveObjectStorageHost")
+		}
+
+		opts.Cluster = opts.Region
+	}
+
+	if opts.PartSize == 0 {
+		opts.PartSize = DefaultUploadPartSize
+	}
+
+	if opts.PartSize < MinUploadPartSize {
+		return "", fmt.Errorf("part size %d is less than the minimum of %d bytes", opts.PartSize, MinUploadPartSize)
+	}
+
+	if opts.Concurrency < 1 {
+		opts.Concurrency = DefaultUploadConcurrency
+	}
+
+	if opts.Endpoint == "" {
+		opts.Endpoint = objectStorageClusterHost(opts.Cluster)
+	}
+
+	objectURL, err := objectStorageObjectURL(opts.Endpoint, bucket, object)
+	if err != nil {
+		return "", err
+	}
+
+	u := objectStorageUploader{opts: opts, httpClient: opts.HTTPClient, objectURL: objectURL}
+	if u.httpClient == nil {
+		u.httpClient = http.DefaultClient
+	}
+
+	uploadID, err := u.initiate(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	parts, err := u.uploadParts(ctx, uploadID, r)
+	if err == nil {
+		var etag string
+		if etag, err = u.complete(ctx, uploadID, parts); err == nil {
+			return etag, nil
+		}
+	}
+
+	// The upload may have been aborted by ctx, so a separate context is used to clean it up
+	abortCtx, cancel := context.WithTimeout(context.Background(), uploadAbortTimeout)
+	defer cancel()
+
+	if abortErr := u.abort(abortCtx, uploadID); abortErr != nil {
+		return "", fmt.Errorf("%w (failed to abort multipart upload: %s)", err, abortErr)
+	}
+
+	return "", err
+}
+
+// UploadObject uploads the contents of r to the given object like the UploadObject function.
+// If opts.Region is set and opts.Endpoint is not, the endpoint is resolved using ResolveObjectStorageHost.
+func (c *Client) UploadObject(ctx context.Context, bucket, object string, r io.Reader, opts UploadOptions) (string, error) {
+	if opts.Region != "" && opts.Endpoint == "" {
+		endpoint, err := c.ResolveObjectStorageHost(ctx, opts.Region)
+		if err != nil {
+			return "", err
+		}
+
+		opts.Endpoint = endpoint
+	}
+
+	return UploadObject(ctx, bucket, object, r, opts)
+}
+
+// uploadParts reads r in parts and uploads them with bounded concurrency,
+// returning the completed parts in order.
+func (u *objectStorageUploader) uploadParts(ctx context.Context, uploadID string, r io.Reader) ([]completedPart, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		parts    []completedPart
+		firstErr error
+	)
+
+	fail := func(err error) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if firstErr == nil {
+			firstErr = err
+			cancel()
+		}
+	}
+
+	sem := make(chan struct{}, u.opts.Concurrency)
+
+	for partNumber := 1; ; partNumber++ {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+		}
+
+		if ctx.Err() != nil {
+			fail(ctx.Err())
+			break
+		}
+
+		buf := make([]byte, u.opts.PartSize)
+
+		n, err := io.ReadFull(r, buf)
+		if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+			<-sem
+			fail(fmt.Errorf("failed to read part %d: %w", partNumber, err))
+
+			break
+		}
+
+		// An empty object is uploaded as a single empty part
+		if n == 0 && partNumber > 1 {
+			<-sem
+			break
+		}
+
+		mu.Lock()
+		parts = append(parts, completedPart{PartNumber: partNumber})
+		index := len(parts) - 1
+		mu.Unlock()
+
+		wg.Add(1)
+
+		go func(partNumber, index int, data []byte) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			etag, err := u.uploadPart(ctx, uploadID, partNumber, data)
+			if err != nil {
+				fail(err)
+				return
+			}
+
+			mu.Lock()
+			parts[index].ETag = etag
+			mu.Unlock()
+		}(partNumber, index, buf[:n])
+
+		if n < len(buf) {
+			// This was the last part
+			break
+		}
+	}
+
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+
+	return parts, nil
+}
+
+func (u *objectStorageUploader) initiate(ctx context.Context) (string, error) {
+	var result initiateMultipartUploadResult
+
+	headers := http.Header{}
+	if u.opts.ContentType != "" {
+		headers.Set("Content-Type", u.opts.ContentType)
+	}
+
+	resp, err := u.do(ctx, http.MethodPost, url.Values{"uploads": {""}}, headers, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to initiate multipart upload: %w", err)
+	}
+
+	if err := xml.Unmarshal(resp, &result); err != nil {
+		return "", fmt.Errorf("failed to parse multipart upload: %w", err)
+	}
+
+	return result.UploadID, nil
+}
+
+func (u *objectStorageUploader) uploadPart(ctx context.Context, uploadID string, partNumber int, data []byte) (string, error) {
+	query := url.Values{
+		"partNumber": {strconv.Itoa(partNumber)},
+		"uploadId":   {uploadID},
+	}
+
+	var etag string
+
+	_, err := u.doWithResponse(ctx, http.MethodPut, query, http.Header{}, data, func(resp *http.Response) {
+		etag = resp.Header.Get("ETag")
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to upload part %d: %w", partNumber, err)
+	}
+
+	return etag, nil
+}
+
+func (u *objectStorageUploader) complete(ctx context.Context, uploadID string, parts []completedPart) (string, error) {
+	body, err := xml.Marshal(completeMultipartUpload{Parts: parts})
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := u.do(ctx, http.MethodPost, url.Values{"uploadId": {uploadID}}, http.Header{}, body)
+	if err != nil {
+		return "", fmt.Errorf("failed to complete multipart upload: %w", err)
+	}
+
+	// S3 may report an error in the body of a successful response
+	var uploadErr objectStorageError
+	if xml.Unmarshal(resp, &uploadErr) == nil && uploadErr.Code != "" {
+		return "", fmt.Errorf("failed to complete multipart upload: %s: %s", uploadErr.Code, uploadErr.Message)
+	}
+
+	var result completeMultipartUploadResult
+	if err := xml.Unmarshal(resp, &result); err != nil {
+		return "", fmt.Errorf("failed to parse completed multipart upload: %w", err)
+	}
+
+	return result.ETag, nil
+}
+
+func (u *objectStorageUploader) abort(ctx context.Context, uploadID string) error {
+	_, err := u.do(ctx, http.MethodDelete, url.Values{"uploadId": {uploadID}}, http.Header{}, nil)
+	return err
+}
+
+func (u *objectStorageUploader) do(ctx context.Context, method string, query url.Values, headers http.Header, body []byte) ([]byte, error) {
+	return u.doWithResponse(ctx, method, query, headers, body, nil)
+}
+
+// doWithResponse makes a signed request to the object and returns the response body.
+func (u *objectStorageUploader) doWithResponse(
+	ctx context.Context, method string, query url.Values, headers http.Header, body []byte, onResponse func(*http.Response),
+) ([]byte, error) {
+	reqURL := *u.objectURL
+	reqURL.RawQuery = sigV4CanonicalQuery(query)
+
+	req, err := http.NewRequestWithContext(ctx, method, reqURL.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header = headers
+
+	payloadHash := sha256.Sum256(body)
+	signRequestV4(req, u.opts.AccessKey, u.opts.SecretKey, u.opts.Cluster, hex.EncodeToString(payloadHash[:]), time.Now())
+
+	resp, err := u.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		objErr := objectStorageError{}
+		if xml.Unmarshal(respBody, &objErr) != nil {
+			objErr = objectStorageError{}
+		}
+
+		objErr.StatusCode = resp.StatusCode
+
+		return nil, objErr
+	}
+
+	if onResponse != nil {
+		onResponse(resp)
+	}
+
+	return respBody, nil
+}
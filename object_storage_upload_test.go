@@ -0,0 +1,203 @@
+package linodego
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// fakeObjectStorage is a minimal S3 multipart upload emulator
+type fakeObjectStorage struct {
+	mu        sync.Mutex
+	parts     map[int][]byte
+	completed []byte
+	aborted   bool
+	failPart  int
+	paths     []string
+}
+
+func (s *fakeObjectStorage) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.paths = append(s.paths, r.URL.EscapedPath())
+
+	if !strings.HasPrefix(r.Header.Get("Authorization"), sigV4Algorithm+" Credential=access/") {
+		rw.WriteHeader(http.StatusForbidden)
+		return
+	}
+
+	query := r.URL.Query()
+
+	switch {
+	case r.Method == http.MethodPost && query.Has("uploads"):
+		rw.Write([]byte(`<InitiateMultipartUploadResult><UploadId>upload-1</UploadId></InitiateMultipartUploadResult>`))
+	case r.Method == http.MethodPut && query.Get("uploadId") == "upload-1":
+		partNumber, _ := strconv.Atoi(query.Get("partNumber"))
+		if partNumber == s.failPart {
+			rw.WriteHeader(http.StatusInternalServerError)
+			rw.Write([]byte(`<Error><Code>InternalError</Code><Message>part failed</Message></Error>`))
+
+			return
+		}
+
+		body, _ := io.ReadAll(r.Body)
+		s.parts[partNumber] = body
+		rw.Header().Set("ETag", fmt.Sprintf(`"etag-%d"`, partNumber))
+	case r.Method == http.MethodPost && query.Get("uploadId") == "upload-1":
+		var complete completeMultipartUpload
+		body, _ := io.ReadAll(r.Body)
+
+		if err := xml.Unmarshal(body, &complete); err != nil {
+			rw.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		for i, part := range complete.Parts {
+			if part.PartNumber != i+1 || part.ETag != fmt.Sprintf(`"etag-%d"`, i+1) {
+				rw.WriteHeader(http.StatusBadRequest)
+				rw.Write([]byte(`<Error><Code>InvalidPart</Code><Message>bad part</Message></Error>`))
+
+				return
+			}
+
+			s.completed = append(s.completed, s.parts[part.PartNumber]...)
+		}
+
+		rw.Write([]byte(`<CompleteMultipartUploadResult><ETag>"final"</ETag></CompleteMultipartUploadResult>`))
+	case r.Method == http.MethodDelete && query.Get("uploadId") == "upload-1":
+		s.aborted = true
+		rw.WriteHeader(http.StatusNoContent)
+	default:
+		rw.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func TestUploadObject(t *testing.T) {
+	storage := &fakeObjectStorage{parts: map[int][]byte{}}
+	ts := httptest.NewServer(storage)
+	defer ts.Close()
+
+	data := bytes.Repeat([]byte("0123456789"), MinUploadPartSize/4)
+
+	etag, err := UploadObject(context.Background(), "bucket", "dir/my file.txt", bytes.NewReader(data), UploadOptions{
+		AccessKey: "access",
+		SecretKey: "secret",
+		Cluster:   "us-east-1",
+		Endpoint:  ts.URL,
+		PartSize:  MinUploadPartSize,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if etag != `"final"` {
+		t.Errorf("expected final ETag, got %q", etag)
+	}
+
+	if len(storage.parts) != 3 {
+		t.Errorf("expected 3 parts, got %d", len(storage.parts))
+	}
+
+	if !bytes.Equal(storage.completed, data) {
+		t.Error("expected the uploaded object to match the input")
+	}
+
+	if storage.aborted {
+		t.Error("expected the upload not to be aborted")
+	}
+
+	if storage.paths[0] != "/bucket/dir/my%20file.txt" {
+		t.Errorf("unexpected object path %q", storage.paths[0])
+	}
+}
+
+func TestUploadObject_Empty(t *testing.T) {
+	storage := &fakeObjectStorage{parts: map[int][]byte{}}
+	ts := httptest.NewServer(storage)
+	defer ts.Close()
+
+	_, err := UploadObject(context.Background(), "bucket", "empty", strings.NewReader(""), UploadOptions{
+		AccessKey: "access",
+		SecretKey: "secret",
+		Cluster:   "us-east-1",
+		Endpoint:  ts.URL,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(storage.parts) != 1 || len(storage.parts[1]) != 0 {
+		t.Errorf("expected a single empty part, got %v", storage.parts)
+	}
+}
+
+func TestUploadObject_Abort(t *testing.T) {
+	storage := &fakeObjectStorage{parts: map[int][]byte{}, failPart: 2}
+	ts := httptest.NewServer(storage)
+	defer ts.Close()
+
+	data := bytes.Repeat([]byte("a"), 3*MinUploadPartSize)
+
+	_, err := UploadObject(context.Background(), "bucket", "object", bytes.NewReader(data), UploadOptions{
+		AccessKey: "access",
+		SecretKey: "secret",
+		Cluster:   "us-east-1",
+		Endpoint:  ts.URL,
+		PartSize:  MinUploadPartSize,
+	})
+	if err == nil || !strings.Contains(err.Error(), "InternalError") {
+		t.Fatalf("expected the part error, got %v", err)
+	}
+
+	if !storage.aborted {
+		t.Error("expected the upload to be aborted")
+	}
+}
+
+func TestUploadObject_ContextCancelled(t *testing.T) {
+	storage := &fakeObjectStorage{parts: map[int][]byte{}}
+	ts := httptest.NewServer(storage)
+	defer ts.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	// Cancel the upload while the reader is being consumed
+	r := io.MultiReader(
+		bytes.NewReader(make([]byte, MinUploadPartSize)),
+		readerFunc(func(p []byte) (int, error) {
+			cancel()
+			return 0, ctx.Err()
+		}),
+	)
+
+	_, err := UploadObject(ctx, "bucket", "object", r, UploadOptions{
+		AccessKey: "access",
+		SecretKey: "secret",
+		Cluster:   "us-east-1",
+		Endpoint:  ts.URL,
+		PartSize:  MinUploadPartSize,
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+
+	if !storage.aborted {
+		t.Error("expected the upload to be aborted")
+	}
+}
+
+type readerFunc func([]byte) (int, error)
+
+func (f readerFunc) Read(p []byte) (int, error) {
+	return f(p)
+}
@@ -12,6 +12,7 @@ import (
 	"fmt"
 	"reflect"
 	"strconv"
+	"sync"
 
 	"github.com/go-resty/resty/v2"
 )
@@ -30,6 +31,18 @@ type ListOptions struct {
 	PageSize int    `json:"page_size"`
 	Filter   string `json:"filter"`
 
+	// FetchAll causes list endpoints to fetch every page starting at Page
+	// and return all results in a single slice.
+	// All pages are already fetched when Page is not set.
+	FetchAll bool `json:"fetch_all"`
+
+	// OrderBy is the field to order results by. It is merged into Filter as "+order_by".
+	OrderBy string `json:"order_by"`
+
+	// Order is the direction to order results in (Ascending/Descending).
+	// It is merged into Filter as "+order".
+	Order string `json:"order"`
+
 	// QueryParams allows for specifying custom query parameters on list endpoint
 	// calls. QueryParams should be an instance of a struct containing fields with
 	// the `query` tag.
@@ -79,13 +92,84 @@ func applyListOptionsToRequest(opts *ListOptions, req *resty.Request) error {
 		req.SetQueryParam("page_size", strconv.Itoa(opts.PageSize))
 	}
 
-	if len(opts.Filter) > 0 {
-		req.SetHeader("X-Filter", opts.Filter)
+	filter, err := opts.filterWithOrder()
+	if err != nil {
+		return fmt.Errorf("failed to apply list options: %w", err)
+	}
+
+	if len(filter) > 0 {
+		req.SetHeader("X-Filter", filter)
 	}
 
 	return nil
 }
 
+// filterWithOrder returns the Filter of the ListOptions with OrderBy and Order merged into it.
+func (l ListOptions) filterWithOrder() (string, error) {
+	if l.Order != "" && l.Order != Ascending && l.Order != Descending {
+		return "", fmt.Errorf("invalid order %q, must be %q or %q", l.Order, Ascending, Descending)
+	}
+
+	if l.OrderBy == "" && l.Order == "" {
+		return l.Filter, nil
+	}
+
+	filter := make(map[string]any)
+
+	if len(l.Filter) > 0 {
+		if err := json.Unmarshal([]byte(l.Filter), &filter); err != nil {
+			return "", fmt.Errorf("failed to merge order into filter: %w", err)
+		}
+	}
+
+	if l.OrderBy != "" {
+		filter["+order_by"] = l.OrderBy
+	}
+
+	if l.Order != "" {
+		filter["+order"] = l.Order
+	}
+
+	result, err := json.Marshal(filter)
+	if err != nil {
+		return "", fmt.Errorf("failed to merge order into filter: %w", err)
+	}
+
+	return string(result), nil
+}
+
+// withFilterField returns a copy of opts with the given field merged into its Filter.
+// The PageOptions of the copy are shared with opts so that the results are reported to the caller.
+func withFilterField(opts *ListOptions, key string, value any) (*ListOptions, error) {
+	if opts == nil {
+		opts = &ListOptions{}
+	}
+
+	if opts.PageOptions == nil {
+		opts.PageOptions = &PageOptions{}
+	}
+
+	filter := make(map[string]any)
+
+	if len(opts.Filter) > 0 {
+		if err := json.Unmarshal([]byte(opts.Filter), &filter); err != nil {
+			return nil, fmt.Errorf("failed to merge %s into filter: %w", key, err)
+		}
+	}
+
+	filter[key] = value
+
+	result, err := json.Marshal(filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to merge %s into filter: %w", key, err)
+	}
+
+	merged := *opts
+	merged.Filter = string(result)
+
+	return &merged, nil
+}
+
 type PagedResponse interface {
 	endpoint(...any) string
 	castResult(*resty.Request, string) (int, int, error)
@@ -93,31 +177,40 @@ type PagedResponse interface {
 
 // listHelper abstracts fetching and pagination for GET endpoints that
 // do not require any Ids (top level endpoints).
-// When opts (or opts.Page) is nil, all pages will be fetched and
-// returned in a single (endpoint-specific)PagedResponse
+// When opts (or opts.Page) is nil, or opts.FetchAll is set, all pages will be
+// fetched and returned in a single (endpoint-specific)PagedResponse
 // opts.results and opts.pages will be updated from the API response
 func (c *Client) listHelper(ctx context.Context, pager PagedResponse, opts *ListOptions, ids ...any) error {
-	req := c.R(ctx)
-	if err := applyListOptionsToRequest(opts, req); err != nil {
-		return err
-	}
-
-	pages, results, err := pager.castResult(req, pager.endpoint(ids...))
-	if err != nil {
-		return err
-	}
 	if opts == nil {
 		opts = &ListOptions{PageOptions: &PageOptions{Page: 0}}
 	}
 	if opts.PageOptions == nil {
 		opts.PageOptions = &PageOptions{Page: 0}
 	}
-	if opts.Page == 0 {
-		for page := 2; page <= pages; page++ {
-			opts.Page = page
-			if err := c.listHelper(ctx, pager, opts, ids...); err != nil {
+
+	fetchAll := opts.Page == 0 || opts.FetchAll
+	firstPage := opts.Page
+	if firstPage < 1 {
+		firstPage = 1
+	}
+
+	pages, results, err := c.listPage(ctx, pager, opts, ids...)
+	if err != nil {
+		return err
+	}
+
+	if fetchAll && pages > firstPage {
+		if c.pageFetchConcurrency > 1 {
+			if err := c.listRemainingPagesConcurrently(ctx, pager, opts, firstPage+1, pages, ids...); err != nil {
 				return err
 			}
+		} else {
+			for page := firstPage + 1; page <= pages; page++ {
+				opts.Page = page
+				if _, _, err := c.listPage(ctx, pager, opts, ids...); err != nil {
+					return err
+				}
+			}
 		}
 	}
 
@@ -126,6 +219,255 @@ func (c *Client) listHelper(ctx context.Context, pager PagedResponse, opts *List
 	return nil
 }
 
+// listRemainingPagesConcurrently fetches pages firstPage through lastPage using up to
+// c.pageFetchConcurrency concurrent requests. The results are appended to pager in page order.
+// If any page fails, the remaining requests are cancelled and the error of the first page
+// to fail is returned, rather than the errors of the requests that were cancelled because of it.
+func (c *Client) listRemainingPagesConcurrently(
+	parentCtx context.Context,
+	pager PagedResponse,
+	opts *ListOptions,
+	firstPage, lastPage int,
+	ids ...any,
+) error {
+	ctx, cancel := context.WithCancel(parentCtx)
+	defer cancel()
+
+	pagerType := reflect.TypeOf(pager).Elem()
+	count := lastPage - firstPage + 1
+
+	pageResults := make([]PagedResponse, count)
+
+	var (
+		mu       sync.Mutex
+		firstErr error
+	)
+
+	fail := func(err error) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		// Requests failing after the cancellation are failing because of it
+		if firstErr == nil && ctx.Err() == nil {
+			firstErr = err
+		}
+
+		cancel()
+	}
+
+	workers := c.pageFetchConcurrency
+	if workers > count {
+		workers = count
+	}
+
+	work := make(chan int)
+
+	var wg sync.WaitGroup
+	wg.Add(workers)
+
+	for i := 0; i < workers; i++ {
+		go func() {
+			defer wg.Done()
+
+			for index := range work {
+				pageOpts := *opts
+				pageOpts.PageOptions = &PageOptions{Page: firstPage + index}
+
+				pageResult, ok := reflect.New(pagerType).Interface().(PagedResponse)
+				if !ok {
+					fail(fmt.Errorf("failed to create paged response of type %s", pagerType))
+					continue
+				}
+
+				if _, _, err := c.listPage(ctx, pageResult, &pageOpts, ids...); err != nil {
+					fail(err)
+					continue
+				}
+
+				pageResults[index] = pageResult
+			}
+		}()
+	}
+
+	for index := 0; index < count; index++ {
+		select {
+		case work <- index:
+		case <-ctx.Done():
+		}
+	}
+
+	close(work)
+	wg.Wait()
+
+	if firstErr != nil {
+		return firstErr
+	}
+
+	// Without a page error, only the parent context can have cancelled the requests
+	if err := parentCtx.Err(); err != nil {
+		return err
+	}
+
+	data := reflect.ValueOf(pager).Elem().FieldByName("Data")
+	for _, pageResult := range pageResults {
+		if pageResult == nil {
+			continue
+		}
+
+		data.Set(reflect.AppendSlice(data, reflect.ValueOf(pageResult).Elem().FieldByName("Data")))
+	}
+
+	opts.Page = lastPage
+
+	return nil
+}
+
+// listPage fetches the single page of results described by opts
+// and returns the total number of pages and results.
+func (c *Client) listPage(ctx context.Context, pager PagedResponse, opts *ListOptions, ids ...any) (int, int, error) {
+	req := c.R(ctx)
+	if err := applyListOptionsToRequest(opts, req); err != nil {
+		return 0, 0, err
+	}
+
+	return pager.castResult(req, pager.endpoint(ids...))
+}
+
+// ListFunc is a function that lists a single page of resources,
+// such as Client.ListInstances.
+type ListFunc[T any] func(ctx context.Context, opts *ListOptions) ([]T, error)
+
+// Pager iterates over the pages of a List endpoint one page at a time.
+// A Pager is not safe for concurrent use.
+type Pager[T any] struct {
+	fn   ListFunc[T]
+	opts ListOptions
+	done bool
+}
+
+// NewPager creates a Pager that fetches pages using fn.
+// The Filter, PageSize and QueryParams of opts are used for every page.
+// Iteration starts at opts.Page, or at the first page if it is unset.
+func NewPager[T any](fn ListFunc[T], opts *ListOptions) *Pager[T] {
+	pager := &Pager[T]{fn: fn}
+
+	if opts != nil {
+		pager.opts = *opts
+	}
+
+	page := 1
+	if pager.opts.PageOptions != nil && pager.opts.Page > 0 {
+		page = pager.opts.Page
+	}
+
+	pager.opts.PageOptions = &PageOptions{Page: page}
+	// Pages are fetched one at a time by the Pager
+	pager.opts.FetchAll = false
+
+	return pager
+}
+
+// Next fetches the next page of results. It returns false once all pages have been fetched.
+// If an error is returned, the page is not consumed and calling Next again will retry it.
+func (p *Pager[T]) Next(ctx context.Context) ([]T, bool, error) {
+	if p.done {
+		return nil, false, nil
+	}
+
+	opts := p.opts
+	opts.PageOptions = &PageOptions{Page: p.opts.Page}
+
+	results, err := p.fn(ctx, &opts)
+	if err != nil {
+		return nil, false, err
+	}
+
+	p.opts.Pages = opts.Pages
+	p.opts.Results = opts.Results
+
+	if p.opts.Page >= p.opts.Pages {
+		p.done = true
+	} else {
+		p.opts.Page++
+	}
+
+	return results, true, nil
+}
+
+// PageOptions returns the pagination state of the Pager as of the last fetched page.
+func (p *Pager[T]) PageOptions() PageOptions {
+	return *p.opts.PageOptions
+}
+
+// ListAll fetches every page of results using fn and returns them in a single slice.
+// If a page fails to be fetched, the results of all previous pages are returned
+// along with the error.
+func ListAll[T any](ctx context.Context, fn ListFunc[T], opts *ListOptions) ([]T, error) {
+	var results []T
+
+	pager := NewPager(fn, opts)
+
+	for {
+		page, ok, err := pager.Next(ctx)
+		if err != nil {
+			return results, err
+		}
+
+		if !ok {
+			return results, nil
+		}
+
+		results = append(results, page...)
+	}
+}
+
+// PageMeta is the pagination metadata of a List request
+type PageMeta struct {
+	// Page is the last page that was fetched
+	Page int
+
+	// Pages is the total number of pages
+	Pages int
+
+	// Results is the total number of results across all pages
+	Results int
+}
+
+// HasMore reports whether there are pages after the last fetched page
+func (m PageMeta) HasMore() bool {
+	return m.Page < m.Pages
+}
+
+// ListWithMeta calls fn like ListFunc and also returns the pagination metadata of the response,
+// e.g. ListWithMeta(ctx, client.ListInstances, linodego.NewListOptions(1, "")).
+// opts is not modified. When every page is fetched, Page of the returned PageMeta is the last page.
+func ListWithMeta[T any](ctx context.Context, fn ListFunc[T], opts *ListOptions) ([]T, PageMeta, error) {
+	var listOpts ListOptions
+	if opts != nil {
+		listOpts = *opts
+	}
+
+	pageOpts := PageOptions{}
+	if listOpts.PageOptions != nil {
+		pageOpts = *listOpts.PageOptions
+	}
+
+	listOpts.PageOptions = &pageOpts
+	fetchAll := pageOpts.Page == 0 || listOpts.FetchAll
+
+	results, err := fn(ctx, &listOpts)
+	if err != nil {
+		return nil, PageMeta{}, err
+	}
+
+	meta := PageMeta{Page: listOpts.Page, Pages: listOpts.Pages, Results: listOpts.Results}
+	if fetchAll {
+		meta.Page = meta.Pages
+	}
+
+	return results, meta, nil
+}
+
 // flattenQueryStruct flattens a structure into a Resty-compatible query param map.
 // Fields are mapped using the `query` struct tag.
 func flattenQueryStruct(val any) (map[string]string, error) {
@@ -1,9 +1,16 @@
 package linodego
 
 import (
-	"github.com/google/go-cmp/cmp"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
 	"reflect"
+	"strconv"
+	"strings"
 	"testing"
+
+	"github.com/google/go-cmp/cmp"
 )
 
 func TestFlattenQueryStruct(t *testing.T) {
@@ -46,3 +53,315 @@ func TestFlattenQueryStruct(t *testing.T) {
 		t.Fatalf("diff in result: %v", cmp.Diff(result, expectedOutput))
 	}
 }
+
+func createPagedTestServer(t *testing.T, pages int, failPage int) (*httptest.Server, *Client) {
+	t.Helper()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		if page == 0 {
+			page = 1
+		}
+
+		rw.Header().Set("Content-Type", "application/json")
+
+		if page == failPage {
+			rw.WriteHeader(http.StatusBadRequest)
+			rw.Write([]byte(`{"errors": [{"reason": "Bad page"}]}`))
+			return
+		}
+
+		if r.Header.Get("X-Filter") != `{"label":"test"}` {
+			t.Errorf("expected filter to be sent for page %d", page)
+		}
+
+		response := VolumesPagedResponse{
+			PageOptions: &PageOptions{Page: page, Pages: pages, Results: pages},
+			Data:        []Volume{{ID: page}},
+		}
+
+		if err := json.NewEncoder(rw).Encode(response); err != nil {
+			t.Fatal(err)
+		}
+	}))
+
+	client := NewClient(nil)
+	client.SetBaseURL(ts.URL)
+
+	return ts, &client
+}
+
+func TestListAll(t *testing.T) {
+	ts, client := createPagedTestServer(t, 3, 0)
+	defer ts.Close()
+
+	volumes, err := ListAll(context.Background(), client.ListVolumes, NewListOptions(0, `{"label":"test"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(volumes) != 3 {
+		t.Fatalf("expected 3 volumes but got %d", len(volumes))
+	}
+
+	for i, volume := range volumes {
+		if volume.ID != i+1 {
+			t.Errorf("expected volume %d to be from page %d but got %d", i, i+1, volume.ID)
+		}
+	}
+}
+
+func TestListAllError(t *testing.T) {
+	ts, client := createPagedTestServer(t, 3, 3)
+	defer ts.Close()
+
+	volumes, err := ListAll(context.Background(), client.ListVolumes, NewListOptions(0, `{"label":"test"}`))
+	if err == nil {
+		t.Fatal("expected error for failed page")
+	}
+
+	if len(volumes) != 2 {
+		t.Fatalf("expected results of the first 2 pages to be returned, got %d", len(volumes))
+	}
+}
+
+func TestPager(t *testing.T) {
+	ts, client := createPagedTestServer(t, 2, 0)
+	defer ts.Close()
+
+	pager := NewPager(client.ListVolumes, NewListOptions(0, `{"label":"test"}`))
+
+	var pages int
+
+	for {
+		volumes, ok, err := pager.Next(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !ok {
+			break
+		}
+
+		pages++
+
+		if len(volumes) != 1 || volumes[0].ID != pages {
+			t.Errorf("unexpected results for page %d: %v", pages, volumes)
+		}
+	}
+
+	if pages != 2 {
+		t.Errorf("expected 2 pages but got %d", pages)
+	}
+
+	if pager.PageOptions().Pages != 2 {
+		t.Errorf("expected pager to record 2 pages but got %d", pager.PageOptions().Pages)
+	}
+}
+
+func TestListWithMeta(t *testing.T) {
+	ts, client := createPagedTestServer(t, 3, 0)
+	defer ts.Close()
+
+	opts := NewListOptions(2, `{"label":"test"}`)
+
+	volumes, meta, err := ListWithMeta(context.Background(), client.ListVolumes, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(volumes) != 1 || meta != (PageMeta{Page: 2, Pages: 3, Results: 3}) || !meta.HasMore() {
+		t.Errorf("unexpected results for page 2: %v %+v", volumes, meta)
+	}
+
+	if opts.Pages != 0 {
+		t.Errorf("expected the options not to be modified, got %+v", opts.PageOptions)
+	}
+
+	volumes, meta, err = ListWithMeta(context.Background(), client.ListVolumes, &ListOptions{Filter: `{"label":"test"}`})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(volumes) != 3 || meta != (PageMeta{Page: 3, Pages: 3, Results: 3}) || meta.HasMore() {
+		t.Errorf("unexpected results for all pages: %v %+v", volumes, meta)
+	}
+}
+
+func TestListOptionsFetchAll(t *testing.T) {
+	ts, client := createPagedTestServer(t, 3, 0)
+	defer ts.Close()
+
+	opts := NewListOptions(2, `{"label":"test"}`)
+
+	volumes, err := client.ListVolumes(context.Background(), opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(volumes) != 1 || volumes[0].ID != 2 {
+		t.Fatalf("expected only page 2 without FetchAll, got %v", volumes)
+	}
+
+	opts = NewListOptions(2, `{"label":"test"}`)
+	opts.FetchAll = true
+
+	volumes, err = client.ListVolumes(context.Background(), opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(volumes) != 2 || volumes[0].ID != 2 || volumes[1].ID != 3 {
+		t.Fatalf("expected pages 2 and 3 in order with FetchAll, got %v", volumes)
+	}
+}
+
+func TestListConcurrentPageFetch(t *testing.T) {
+	ts, client := createPagedTestServer(t, 7, 0)
+	defer ts.Close()
+
+	client.SetPageFetchConcurrency(3)
+
+	volumes, err := client.ListVolumes(context.Background(), NewListOptions(0, `{"label":"test"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(volumes) != 7 {
+		t.Fatalf("expected 7 volumes but got %d", len(volumes))
+	}
+
+	for i, volume := range volumes {
+		if volume.ID != i+1 {
+			t.Errorf("expected volume %d to be from page %d but got %d", i, i+1, volume.ID)
+		}
+	}
+}
+
+func TestListConcurrentPageFetchError(t *testing.T) {
+	ts, client := createPagedTestServer(t, 7, 4)
+	defer ts.Close()
+
+	client.SetPageFetchConcurrency(3)
+
+	if _, err := client.ListVolumes(context.Background(), NewListOptions(0, `{"label":"test"}`)); err == nil {
+		t.Fatal("expected error for failed page")
+	}
+}
+
+func TestListConcurrentPageFetchError_cancelledPages(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		if page == 0 {
+			page = 1
+		}
+
+		rw.Header().Set("Content-Type", "application/json")
+
+		switch page {
+		case 2:
+			// An earlier page is still in flight when a later page fails
+			<-r.Context().Done()
+			return
+		case 3:
+			rw.WriteHeader(http.StatusBadRequest)
+			rw.Write([]byte(`{"errors": [{"reason": "Bad page"}]}`))
+			return
+		}
+
+		json.NewEncoder(rw).Encode(VolumesPagedResponse{
+			PageOptions: &PageOptions{Page: page, Pages: 4, Results: 4},
+			Data:        []Volume{{ID: page}},
+		})
+	}))
+	defer ts.Close()
+
+	client := NewClient(nil)
+	client.SetBaseURL(ts.URL)
+	client.SetPageFetchConcurrency(3)
+
+	_, err := client.ListVolumes(context.Background(), nil)
+	if err == nil || !strings.Contains(err.Error(), "Bad page") {
+		t.Fatalf("expected the error of the failed page, got %v", err)
+	}
+}
+
+func TestListOptionsOrder(t *testing.T) {
+	client := NewClient(nil)
+
+	for _, tc := range []struct {
+		name     string
+		opts     ListOptions
+		expected map[string]any
+	}{
+		{
+			name:     "no order",
+			opts:     ListOptions{Filter: `{"label":"test"}`},
+			expected: map[string]any{"label": "test"},
+		},
+		{
+			name:     "order without filter",
+			opts:     ListOptions{OrderBy: "label", Order: Descending},
+			expected: map[string]any{"+order_by": "label", "+order": "desc"},
+		},
+		{
+			name:     "order merged into filter",
+			opts:     ListOptions{Filter: `{"label":"test","+order":"asc"}`, OrderBy: "id", Order: Descending},
+			expected: map[string]any{"label": "test", "+order_by": "id", "+order": "desc"},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			req := client.R(context.Background())
+			if err := applyListOptionsToRequest(&tc.opts, req); err != nil {
+				t.Fatal(err)
+			}
+
+			var filter map[string]any
+			if err := json.Unmarshal([]byte(req.Header.Get("X-Filter")), &filter); err != nil {
+				t.Fatal(err)
+			}
+
+			if !cmp.Equal(filter, tc.expected) {
+				t.Errorf("expected filter %v, got %v", tc.expected, filter)
+			}
+		})
+	}
+
+	if _, err := client.ListVolumes(context.Background(), &ListOptions{Order: "up"}); err == nil {
+		t.Error("expected an invalid order to return an error")
+	}
+
+	if err := applyListOptionsToRequest(&ListOptions{Filter: "{", Order: Ascending}, client.R(context.Background())); err == nil {
+		t.Error("expected an invalid filter to return an error when merging the order")
+	}
+}
+
+func TestWithFilterField(t *testing.T) {
+	opts := &ListOptions{Filter: `{"label":"test"}`}
+
+	merged, err := withFilterField(opts, "subnet_id", 123)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var filter map[string]any
+	if err := json.Unmarshal([]byte(merged.Filter), &filter); err != nil {
+		t.Fatal(err)
+	}
+
+	if !cmp.Equal(filter, map[string]any{"label": "test", "subnet_id": float64(123)}) {
+		t.Errorf("unexpected merged filter: %v", filter)
+	}
+
+	if opts.Filter != `{"label":"test"}` {
+		t.Errorf("expected the original filter to be unchanged, got %s", opts.Filter)
+	}
+
+	if merged.PageOptions != opts.PageOptions {
+		t.Error("expected the page options to be shared with the original options")
+	}
+
+	if merged, err := withFilterField(nil, "subnet_id", 123); err != nil || merged.Filter != `{"subnet_id":123}` {
+		t.Errorf("unexpected result for nil options: %v, %v", merged, err)
+	}
+}
@@ -0,0 +1,223 @@
+package linodego
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// PlacementGroupType is the affinity type of a PlacementGroup
+type PlacementGroupType string
+
+// PlacementGroupType constants are the affinity types accepted by the API
+const (
+	PlacementGroupTypeAffinityLocal     PlacementGroupType = "affinity:local"
+	PlacementGroupTypeAntiAffinityLocal PlacementGroupType = "anti_affinity:local"
+)
+
+// PlacementGroupPolicy is the enforcement policy of a PlacementGroup
+type PlacementGroupPolicy string
+
+// PlacementGroupPolicy constants reflect whether the API rejects Linodes that would
+// make a PlacementGroup non-compliant
+const (
+	PlacementGroupPolicyStrict   PlacementGroupPolicy = "strict"
+	PlacementGroupPolicyFlexible PlacementGroupPolicy = "flexible"
+)
+
+// PlacementGroup represents a group of Linodes placed on hosts according to its affinity type
+type PlacementGroup struct {
+	ID                   int                    `json:"id"`
+	Label                string                 `json:"label"`
+	Region               string                 `json:"region"`
+	PlacementGroupType   PlacementGroupType     `json:"placement_group_type"`
+	PlacementGroupPolicy PlacementGroupPolicy   `json:"placement_group_policy"`
+	IsCompliant          bool                   `json:"is_compliant"`
+	Members              []PlacementGroupMember `json:"members"`
+}
+
+// PlacementGroupMember is a Linode assigned to a PlacementGroup
+type PlacementGroupMember struct {
+	LinodeID    int  `json:"linode_id"`
+	IsCompliant bool `json:"is_compliant"`
+}
+
+// PlacementGroupCreateOptions fields are those accepted by CreatePlacementGroup
+type PlacementGroupCreateOptions struct {
+	Label                string               `json:"label"`
+	Region               string               `json:"region"`
+	PlacementGroupType   PlacementGroupType   `json:"placement_group_type"`
+	PlacementGroupPolicy PlacementGroupPolicy `json:"placement_group_policy"`
+}
+
+// PlacementGroupUpdateOptions fields are those accepted by UpdatePlacementGroup
+type PlacementGroupUpdateOptions struct {
+	Label string `json:"label,omitempty"`
+}
+
+// PlacementGroupAssignOptions fields are those accepted by AssignPlacementGroupLinodes
+type PlacementGroupAssignOptions struct {
+	Linodes []int `json:"linodes"`
+
+	// CompliantOnly only assigns the Linodes if the group remains compliant
+	CompliantOnly *bool `json:"compliant_only,omitempty"`
+}
+
+// PlacementGroupUnassignOptions fields are those accepted by UnassignPlacementGroupLinodes
+type PlacementGroupUnassignOptions struct {
+	Linodes []int `json:"linodes"`
+}
+
+// Validate checks that the affinity type and policy of the options are ones accepted by the API
+func (opts PlacementGroupCreateOptions) Validate() error {
+	switch opts.PlacementGroupType {
+	case PlacementGroupTypeAffinityLocal, PlacementGroupTypeAntiAffinityLocal:
+	default:
+		return fmt.Errorf("invalid placement group type %q, must be %q or %q",
+			opts.PlacementGroupType, PlacementGroupTypeAffinityLocal, PlacementGroupTypeAntiAffinityLocal)
+	}
+
+	switch opts.PlacementGroupPolicy {
+	case PlacementGroupPolicyStrict, PlacementGroupPolicyFlexible:
+	default:
+		return fmt.Errorf("invalid placement group policy %q, must be %q or %q",
+			opts.PlacementGroupPolicy, PlacementGroupPolicyStrict, PlacementGroupPolicyFlexible)
+	}
+
+	return nil
+}
+
+// GetCreateOptions converts a PlacementGroup to PlacementGroupCreateOptions for use in CreatePlacementGroup
+func (p PlacementGroup) GetCreateOptions() PlacementGroupCreateOptions {
+	return PlacementGroupCreateOptions{
+		Label:                p.Label,
+		Region:               p.Region,
+		PlacementGroupType:   p.PlacementGroupType,
+		PlacementGroupPolicy: p.PlacementGroupPolicy,
+	}
+}
+
+// GetUpdateOptions converts a PlacementGroup to PlacementGroupUpdateOptions for use in UpdatePlacementGroup
+func (p PlacementGroup) GetUpdateOptions() PlacementGroupUpdateOptions {
+	return PlacementGroupUpdateOptions{
+		Label: p.Label,
+	}
+}
+
+// PlacementGroupsPagedResponse represents a paginated PlacementGroup API response
+type PlacementGroupsPagedResponse struct {
+	*PageOptions
+	Data []PlacementGroup `json:"data"`
+}
+
+// endpoint gets the endpoint URL for PlacementGroup
+func (PlacementGroupsPagedResponse) endpoint(_ ...any) string {
+	return "placement/groups"
+}
+
+func (resp *PlacementGroupsPagedResponse) castResult(r *resty.Request, e string) (int, int, error) {
+	res, err := coupleAPIErrors(r.SetResult(PlacementGroupsPagedResponse{}).Get(e))
+	if err != nil {
+		return 0, 0, err
+	}
+	castedRes := res.Result().(*PlacementGroupsPagedResponse)
+	resp.Data = append(resp.Data, castedRes.Data...)
+	return castedRes.Pages, castedRes.Results, nil
+}
+
+// ListPlacementGroups lists PlacementGroups
+func (c *Client) ListPlacementGroups(ctx context.Context, opts *ListOptions) ([]PlacementGroup, error) {
+	response := PlacementGroupsPagedResponse{}
+	err := c.listHelper(ctx, &response, opts)
+	if err != nil {
+		return nil, err
+	}
+	return response.Data, nil
+}
+
+// GetPlacementGroup gets the PlacementGroup with the provided ID
+func (c *Client) GetPlacementGroup(ctx context.Context, groupID int) (*PlacementGroup, error) {
+	e := fmt.Sprintf("placement/groups/%d", groupID)
+	req := c.R(ctx).SetResult(&PlacementGroup{})
+	r, err := coupleAPIErrors(req.Get(e))
+	if err != nil {
+		return nil, err
+	}
+	return r.Result().(*PlacementGroup), nil
+}
+
+// CreatePlacementGroup creates a PlacementGroup
+func (c *Client) CreatePlacementGroup(ctx context.Context, opts PlacementGroupCreateOptions) (*PlacementGroup, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+
+	body, err := c.marshalJSON(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	e := "placement/groups"
+	req := c.R(ctx).SetResult(&PlacementGroup{}).SetBody(string(body))
+	r, err := coupleAPIErrors(req.Post(e))
+	if err != nil {
+		return nil, err
+	}
+	return r.Result().(*PlacementGroup), nil
+}
+
+// UpdatePlacementGroup updates the PlacementGroup with the provided ID
+func (c *Client) UpdatePlacementGroup(ctx context.Context, groupID int, opts PlacementGroupUpdateOptions) (*PlacementGroup, error) {
+	body, err := c.marshalJSON(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	e := fmt.Sprintf("placement/groups/%d", groupID)
+	req := c.R(ctx).SetResult(&PlacementGroup{}).SetBody(string(body))
+	r, err := coupleAPIErrors(req.Put(e))
+	if err != nil {
+		return nil, err
+	}
+	return r.Result().(*PlacementGroup), nil
+}
+
+// DeletePlacementGroup deletes the PlacementGroup with the provided ID
+func (c *Client) DeletePlacementGroup(ctx context.Context, groupID int) error {
+	e := fmt.Sprintf("placement/groups/%d", groupID)
+	_, err := coupleAPIErrors(c.R(ctx).Delete(e))
+	return err
+}
+
+// AssignPlacementGroupLinodes assigns the provided Linodes to the PlacementGroup with the provided ID
+func (c *Client) AssignPlacementGroupLinodes(ctx context.Context, groupID int, opts PlacementGroupAssignOptions) (*PlacementGroup, error) {
+	body, err := c.marshalJSON(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	e := fmt.Sprintf("placement/groups/%d/assign", groupID)
+	req := c.R(ctx).SetResult(&PlacementGroup{}).SetBody(string(body))
+	r, err := coupleAPIErrors(req.Post(e))
+	if err != nil {
+		return nil, err
+	}
+	return r.Result().(*PlacementGroup), nil
+}
+
+// UnassignPlacementGroupLinodes unassigns the provided Linodes from the PlacementGroup with the provided ID
+func (c *Client) UnassignPlacementGroupLinodes(ctx context.Context, groupID int, opts PlacementGroupUnassignOptions) (*PlacementGroup, error) {
+	body, err := c.marshalJSON(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	e := fmt.Sprintf("placement/groups/%d/unassign", groupID)
+	req := c.R(ctx).SetResult(&PlacementGroup{}).SetBody(string(body))
+	r, err := coupleAPIErrors(req.Post(e))
+	if err != nil {
+		return nil, err
+	}
+	return r.Result().(*PlacementGroup), nil
+}
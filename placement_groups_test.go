@@ -0,0 +1,83 @@
+package linodego
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestPlacementGroupCreateOptions_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    PlacementGroupCreateOptions
+		wantErr bool
+	}{
+		{"anti-affinity", PlacementGroupCreateOptions{PlacementGroupType: PlacementGroupTypeAntiAffinityLocal, PlacementGroupPolicy: PlacementGroupPolicyStrict}, false},
+		{"affinity", PlacementGroupCreateOptions{PlacementGroupType: PlacementGroupTypeAffinityLocal, PlacementGroupPolicy: PlacementGroupPolicyFlexible}, false},
+		{"invalid type", PlacementGroupCreateOptions{PlacementGroupType: "anti_affinity", PlacementGroupPolicy: PlacementGroupPolicyStrict}, true},
+		{"no policy", PlacementGroupCreateOptions{PlacementGroupType: PlacementGroupTypeAffinityLocal}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.opts.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("expected error %t, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestClient_CreatePlacementGroup_invalid(t *testing.T) {
+	client := NewClientFromRoundTripper(roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		t.Errorf("unexpected request %s %s", r.Method, r.URL)
+		return nil, http.ErrNotSupported
+	}))
+
+	if _, err := client.CreatePlacementGroup(context.Background(), PlacementGroupCreateOptions{
+		Label:              "test",
+		Region:             "us-east",
+		PlacementGroupType: "anywhere",
+	}); err == nil {
+		t.Error("expected an error for an invalid placement group type")
+	}
+}
+
+func TestClient_AssignPlacementGroupLinodes(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/v4/placement/groups/123/assign" {
+			rw.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		var opts PlacementGroupAssignOptions
+		if err := json.NewDecoder(r.Body).Decode(&opts); err != nil {
+			t.Error(err)
+		}
+
+		if !reflect.DeepEqual(opts.Linodes, []int{456, 789}) {
+			t.Errorf("unexpected Linodes %v", opts.Linodes)
+		}
+
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(`{"id": 123, "placement_group_type": "anti_affinity:local", "is_compliant": true,
+			"members": [{"linode_id": 456, "is_compliant": true}, {"linode_id": 789, "is_compliant": true}]}`))
+	}))
+	defer ts.Close()
+
+	client := NewClient(nil)
+	client.SetBaseURL(ts.URL)
+
+	group, err := client.AssignPlacementGroupLinodes(context.Background(), 123, PlacementGroupAssignOptions{
+		Linodes: []int{456, 789},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(group.Members) != 2 || group.PlacementGroupType != PlacementGroupTypeAntiAffinityLocal {
+		t.Errorf("unexpected placement group %v", group)
+	}
+}
@@ -12,12 +12,12 @@ import (
 
 // Profile represents a Profile object
 type ProfileLogin struct {
-	Datetime   *time.Time `json:"datetime"`
-	ID         int        `json:"id"`
-	IP         string     `json:"ip"`
-	Restricted bool       `json:"restricted"`
-	Status     string     `json:"status"`
-	Username   string     `json:"username"`
+	Datetime   *time.Time  `json:"datetime"`
+	ID         int         `json:"id"`
+	IP         string      `json:"ip"`
+	Restricted bool        `json:"restricted"`
+	Status     LoginStatus `json:"status"`
+	Username   string      `json:"username"`
 }
 
 type ProfileLoginsPagedResponse struct {
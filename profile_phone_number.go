@@ -2,7 +2,6 @@ package linodego
 
 import (
 	"context"
-	"encoding/json"
 )
 
 // SendPhoneNumberVerificationCodeOptions fields are those accepted by SendPhoneNumberVerificationCode
@@ -18,7 +17,7 @@ type VerifyPhoneNumberOptions struct {
 
 // SendPhoneNumberVerificationCode sends a one-time verification code via SMS message to the submitted phone number.
 func (c *Client) SendPhoneNumberVerificationCode(ctx context.Context, opts SendPhoneNumberVerificationCodeOptions) error {
-	body, err := json.Marshal(opts)
+	body, err := c.marshalJSON(opts)
 	if err != nil {
 		return err
 	}
@@ -37,7 +36,7 @@ func (c *Client) DeletePhoneNumber(ctx context.Context) error {
 
 // VerifyPhoneNumber verifies a phone number by confirming the one-time code received via SMS message after accessing the Phone Verification Code Send command.
 func (c *Client) VerifyPhoneNumber(ctx context.Context, opts VerifyPhoneNumberOptions) error {
-	body, err := json.Marshal(opts)
+	body, err := c.marshalJSON(opts)
 	if err != nil {
 		return err
 	}
@@ -2,7 +2,6 @@ package linodego
 
 import (
 	"context"
-	"encoding/json"
 )
 
 type SecurityQuestion struct {
@@ -37,7 +36,7 @@ func (c *Client) SecurityQuestionsList(ctx context.Context) (*SecurityQuestionsL
 
 // SecurityQuestionsAnswer adds security question responses for your User.
 func (c *Client) SecurityQuestionsAnswer(ctx context.Context, opts SecurityQuestionsAnswerOptions) error {
-	body, err := json.Marshal(opts)
+	body, err := c.marshalJSON(opts)
 	if err != nil {
 		return err
 	}
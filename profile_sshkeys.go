@@ -1,9 +1,14 @@
 package linodego
 
 import (
+	"bytes"
 	"context"
+	"encoding/base64"
+	"encoding/binary"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/go-resty/resty/v2"
@@ -29,6 +34,65 @@ type SSHKeyUpdateOptions struct {
 	Label string `json:"label"`
 }
 
+// sshKeyTypes are the public key types accepted by the API
+var sshKeyTypes = map[string]bool{
+	"ssh-rsa":                            true,
+	"ssh-dss":                            true,
+	"ssh-ed25519":                        true,
+	"ecdsa-sha2-nistp256":                true,
+	"ecdsa-sha2-nistp384":                true,
+	"ecdsa-sha2-nistp521":                true,
+	"sk-ssh-ed25519@openssh.com":         true,
+	"sk-ecdsa-sha2-nistp256@openssh.com": true,
+}
+
+// Validate checks that SSHKey is a public key in the authorized_keys format,
+// i.e. a supported key type followed by the base64 encoded key and an optional comment.
+func (opts SSHKeyCreateOptions) Validate() error {
+	return validateSSHPublicKey(opts.SSHKey)
+}
+
+func validateSSHPublicKey(key string) error {
+	fields := strings.Fields(key)
+	if len(fields) < 2 {
+		return errors.New("invalid SSH public key, expected a key type followed by the base64 encoded key")
+	}
+
+	keyType := fields[0]
+	if !sshKeyTypes[keyType] {
+		return fmt.Errorf("invalid SSH public key, unsupported key type %q", keyType)
+	}
+
+	blob, err := base64.StdEncoding.DecodeString(fields[1])
+	if err != nil {
+		return fmt.Errorf("invalid SSH public key, key is not base64 encoded: %w", err)
+	}
+
+	// The encoded key starts with its length-prefixed key type
+	if len(blob) < 4 {
+		return errors.New("invalid SSH public key, key is truncated")
+	}
+
+	typeLength := binary.BigEndian.Uint32(blob)
+	if uint64(len(blob)-4) < uint64(typeLength) || !bytes.Equal(blob[4:4+typeLength], []byte(keyType)) {
+		return fmt.Errorf("invalid SSH public key, encoded key is not of type %q", keyType)
+	}
+
+	return nil
+}
+
+// AuthorizedKeysFromSSHKeys returns the public keys of SSHKeys, for use in the AuthorizedKeys
+// of InstanceCreateOptions and InstanceRebuildOptions. The AuthorizedUsers options instead
+// authorize all the SSHKeys of the given users.
+func AuthorizedKeysFromSSHKeys(keys ...SSHKey) []string {
+	authorizedKeys := make([]string, len(keys))
+	for i, key := range keys {
+		authorizedKeys[i] = key.SSHKey
+	}
+
+	return authorizedKeys
+}
+
 // UnmarshalJSON implements the json.Unmarshaler interface
 func (i *SSHKey) UnmarshalJSON(b []byte) error {
 	type Mask SSHKey
@@ -106,7 +170,11 @@ func (c *Client) GetSSHKey(ctx context.Context, keyID int) (*SSHKey, error) {
 
 // CreateSSHKey creates a SSHKey
 func (c *Client) CreateSSHKey(ctx context.Context, opts SSHKeyCreateOptions) (*SSHKey, error) {
-	body, err := json.Marshal(opts)
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+
+	body, err := c.marshalJSON(opts)
 	if err != nil {
 		return nil, err
 	}
@@ -122,7 +190,7 @@ func (c *Client) CreateSSHKey(ctx context.Context, opts SSHKeyCreateOptions) (*S
 
 // UpdateSSHKey updates the SSHKey with the specified id
 func (c *Client) UpdateSSHKey(ctx context.Context, keyID int, opts SSHKeyUpdateOptions) (*SSHKey, error) {
-	body, err := json.Marshal(opts)
+	body, err := c.marshalJSON(opts)
 	if err != nil {
 		return nil, err
 	}
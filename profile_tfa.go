@@ -64,7 +64,7 @@ func (c *Client) DisableTwoFactor(ctx context.Context) error {
 
 // ConfirmTwoFactor confirms that you can successfully generate Two Factor codes and enables TFA on your Account.
 func (c *Client) ConfirmTwoFactor(ctx context.Context, opts ConfirmTwoFactorOptions) (*ConfirmTwoFactorResponse, error) {
-	body, err := json.Marshal(opts)
+	body, err := c.marshalJSON(opts)
 	if err != nil {
 		return nil, err
 	}
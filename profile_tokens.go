@@ -143,7 +143,7 @@ func (c *Client) CreateToken(ctx context.Context, opts TokenCreateOptions) (*Tok
 		createOptsFixed.Expiry = &iso8601Expiry
 	}
 
-	body, err := json.Marshal(createOptsFixed)
+	body, err := c.marshalJSON(createOptsFixed)
 	if err != nil {
 		return nil, err
 	}
@@ -159,7 +159,7 @@ func (c *Client) CreateToken(ctx context.Context, opts TokenCreateOptions) (*Tok
 
 // UpdateToken updates the Token with the specified id
 func (c *Client) UpdateToken(ctx context.Context, tokenID int, opts TokenUpdateOptions) (*Token, error) {
-	body, err := json.Marshal(opts)
+	body, err := c.marshalJSON(opts)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,133 @@
+package linodego
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// rateLimiter is a token bucket limiting the rate of requests sent by a Client.
+// It is shared between copies of a Client and is safe for concurrent use.
+type rateLimiter struct {
+	mu sync.Mutex
+
+	// perSecond is the number of tokens added to the bucket every second.
+	// A value of 0 disables the limiter.
+	perSecond float64
+	burst     float64
+	tokens    float64
+	last      time.Time
+
+	// pausedUntil blocks all requests until the given time,
+	// e.g. as requested by a Retry-After header.
+	pausedUntil time.Time
+}
+
+func newRateLimiter() *rateLimiter {
+	return &rateLimiter{}
+}
+
+func (l *rateLimiter) setLimit(perSecond, burst int) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if burst < 1 {
+		burst = 1
+	}
+
+	l.perSecond = math.Max(float64(perSecond), 0)
+	l.burst = float64(burst)
+	l.tokens = l.burst
+	l.last = time.Now()
+}
+
+// pause blocks all requests waiting on the limiter until the given time.
+func (l *rateLimiter) pause(until time.Time) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if until.After(l.pausedUntil) {
+		l.pausedUntil = until
+	}
+}
+
+// reserve takes a token from the bucket if one is available and otherwise
+// returns the duration to wait before trying again.
+func (l *rateLimiter) reserve(now time.Time) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if now.Before(l.pausedUntil) {
+		return l.pausedUntil.Sub(now)
+	}
+
+	if l.perSecond == 0 {
+		return 0
+	}
+
+	l.tokens = math.Min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.perSecond)
+	l.last = now
+
+	if l.tokens >= 1 {
+		l.tokens--
+		return 0
+	}
+
+	return time.Duration((1 - l.tokens) / l.perSecond * float64(time.Second))
+}
+
+// wait blocks until a request may be sent or the context is done.
+func (l *rateLimiter) wait(ctx context.Context) error {
+	for {
+		delay := l.reserve(time.Now())
+		if delay <= 0 {
+			return nil
+		}
+
+		timer := time.NewTimer(delay)
+
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		}
+	}
+}
+
+// SetRequestRateLimit limits the client to perSecond requests per second, allowing
+// bursts of up to burst requests. Requests block until they are allowed by the limiter
+// or their context is done. Every retry attempt counts as a request.
+// When the API responds with a 429 and a Retry-After header, all requests sent
+// by the client are paused until the requested time.
+// A perSecond value of 0 removes the limit.
+func (c *Client) SetRequestRateLimit(perSecond, burst int) *Client {
+	if c.rateLimiter == nil {
+		limiter := newRateLimiter()
+		c.rateLimiter = limiter
+
+		c.resty.OnBeforeRequest(func(_ *resty.Client, req *resty.Request) error {
+			return limiter.wait(req.Context())
+		})
+
+		c.resty.OnAfterResponse(func(_ *resty.Client, resp *resty.Response) error {
+			if resp.StatusCode() != http.StatusTooManyRequests {
+				return nil
+			}
+
+			if retryAfter, err := parseRetryAfter(resp.Header().Get(retryAfterHeaderName), time.Now()); err == nil {
+				limiter.pause(time.Now().Add(retryAfter))
+			}
+
+			return nil
+		})
+	}
+
+	c.rateLimiter.setLimit(perSecond, burst)
+
+	return c
+}
@@ -13,16 +13,62 @@ import (
 // `status` field may update for database outages.
 var cacheExpiryTime = time.Minute
 
+// RegionStatus is the status of a Region
+type RegionStatus string
+
+// RegionStatus constants reflect whether a Region is operating normally
+const (
+	RegionStatusOK     RegionStatus = "ok"
+	RegionStatusOutage RegionStatus = "outage"
+)
+
+// RegionCapability is a capability of a Region, such as the ability to create Linodes.
+// Region.Capabilities may contain capabilities without a constant.
+type RegionCapability string
+
+// RegionCapability constants are the known capabilities of a Region
+const (
+	CapabilityLinodes                RegionCapability = "Linodes"
+	CapabilityNodeBalancers          RegionCapability = "NodeBalancers"
+	CapabilityBlockStorage           RegionCapability = "Block Storage"
+	CapabilityBlockStorageMigrations RegionCapability = "Block Storage Migrations"
+	CapabilityBlockStorageEncryption RegionCapability = "Block Storage Encryption"
+	CapabilityObjectStorage          RegionCapability = "Object Storage"
+	CapabilityGPU                    RegionCapability = "GPU Linodes"
+	CapabilityKubernetes             RegionCapability = "Kubernetes"
+	CapabilityCloudFirewall          RegionCapability = "Cloud Firewall"
+	CapabilityVlans                  RegionCapability = "Vlans"
+	CapabilityVPCs                   RegionCapability = "VPCs"
+	CapabilityDBAAS                  RegionCapability = "Managed Databases"
+	CapabilityMetadata               RegionCapability = "Metadata"
+	CapabilityPremiumPlans           RegionCapability = "Premium Plans"
+	CapabilityEdgePlans              RegionCapability = "Edge Plans"
+	CapabilityPlacementGroup         RegionCapability = "Placement Group"
+	CapabilityDiskEncryption         RegionCapability = "Disk Encryption"
+	CapabilityBareMetal              RegionCapability = "Bare Metal"
+)
+
 // Region represents a linode region object
 type Region struct {
 	ID           string          `json:"id"`
 	Country      string          `json:"country"`
 	Capabilities []string        `json:"capabilities"`
-	Status       string          `json:"status"`
+	Status       RegionStatus    `json:"status"`
 	Resolvers    RegionResolvers `json:"resolvers"`
 	Label        string          `json:"label"`
 }
 
+// HasCapability reports whether the Region has the given capability
+func (r Region) HasCapability(capability RegionCapability) bool {
+	for _, c := range r.Capabilities {
+		if c == string(capability) {
+			return true
+		}
+	}
+
+	return false
+}
+
 // RegionResolvers contains the DNS resolvers of a region
 type RegionResolvers struct {
 	IPv4 string `json:"ipv4"`
@@ -50,7 +96,7 @@ func (resp *RegionsPagedResponse) castResult(r *resty.Request, e string) (int, i
 	return castedRes.Pages, castedRes.Results, nil
 }
 
-// ListRegions lists Regions. This endpoint is cached by default.
+// ListRegions lists Regions. This endpoint is cached when response caching is enabled.
 func (c *Client) ListRegions(ctx context.Context, opts *ListOptions) ([]Region, error) {
 	response := RegionsPagedResponse{}
 
@@ -73,7 +119,31 @@ func (c *Client) ListRegions(ctx context.Context, opts *ListOptions) ([]Region,
 	return response.Data, nil
 }
 
-// GetRegion gets the template with the provided ID. This endpoint is cached by default.
+// FilterRegionsByCapability lists the Regions that have all of the given capabilities.
+// Capabilities cannot be filtered by the API, so all Regions are listed and filtered by the client.
+func (c *Client) FilterRegionsByCapability(ctx context.Context, capabilities ...RegionCapability) ([]Region, error) {
+	regions, err := c.ListRegions(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	filtered := make([]Region, 0, len(regions))
+
+regions:
+	for _, region := range regions {
+		for _, capability := range capabilities {
+			if !region.HasCapability(capability) {
+				continue regions
+			}
+		}
+
+		filtered = append(filtered, region)
+	}
+
+	return filtered, nil
+}
+
+// GetRegion gets the template with the provided ID. This endpoint is cached when response caching is enabled.
 func (c *Client) GetRegion(ctx context.Context, regionID string) (*Region, error) {
 	e := fmt.Sprintf("regions/%s", url.PathEscape(regionID))
 
@@ -8,7 +8,7 @@ import (
 	"github.com/go-resty/resty/v2"
 )
 
-// Region represents a linode region object
+// RegionAvailability reports whether a plan type can currently be deployed in a region
 type RegionAvailability struct {
 	Region    string `json:"region"`
 	Plan      string `json:"plan"`
@@ -36,7 +36,7 @@ func (resp *RegionsAvailabilityPagedResponse) castResult(r *resty.Request, e str
 	return castedRes.Pages, castedRes.Results, nil
 }
 
-// ListRegionsAvailability lists Regions. This endpoint is cached by default.
+// ListRegionsAvailability lists the availability of plan types in all Regions. This endpoint is cached when response caching is enabled.
 func (c *Client) ListRegionsAvailability(ctx context.Context, opts *ListOptions) ([]RegionAvailability, error) {
 	response := RegionsAvailabilityPagedResponse{}
 
@@ -59,12 +59,35 @@ func (c *Client) ListRegionsAvailability(ctx context.Context, opts *ListOptions)
 	return response.Data, nil
 }
 
-// GetRegionAvailability gets the template with the provided ID. This endpoint is cached by default.
+// ListRegionPlanAvailability lists the availability of plan types in the Region with the provided ID.
+// This endpoint is cached when response caching is enabled.
+func (c *Client) ListRegionPlanAvailability(ctx context.Context, regionID string) ([]RegionAvailability, error) {
+	e := fmt.Sprintf("regions/%s/availability", url.PathEscape(regionID))
+
+	if result, ok := c.getCachedResponse(e).([]RegionAvailability); ok {
+		return result, nil
+	}
+
+	req := c.R(ctx).SetResult([]RegionAvailability{})
+	r, err := coupleAPIErrors(req.Get(e))
+	if err != nil {
+		return nil, err
+	}
+
+	availability := *r.Result().(*[]RegionAvailability)
+
+	c.addCachedResponse(e, availability, &cacheExpiryTime)
+
+	return availability, nil
+}
+
+// GetRegionAvailability gets the availability of a plan type in the Region with the provided ID.
+// This endpoint is cached when response caching is enabled.
+// Deprecated: The endpoint lists the availability of every plan type, use ListRegionPlanAvailability.
 func (c *Client) GetRegionAvailability(ctx context.Context, regionID string) (*RegionAvailability, error) {
 	e := fmt.Sprintf("regions/%s/availability", url.PathEscape(regionID))
 
-	if result := c.getCachedResponse(e); result != nil {
-		result := result.(RegionAvailability)
+	if result, ok := c.getCachedResponse(e).(RegionAvailability); ok {
 		return &result, nil
 	}
 
@@ -78,3 +101,26 @@ func (c *Client) GetRegionAvailability(ctx context.Context, regionID string) (*R
 
 	return r.Result().(*RegionAvailability), nil
 }
+
+// IsTypeAvailable reports whether instances of the type typeID can currently be created in the region,
+// using ListRegionPlanAvailability. Types without any reported availability are not capacity
+// restricted and are considered available. Enable response caching with UseCache to avoid fetching
+// the availability of the region for every call, as it changes slowly.
+func (c *Client) IsTypeAvailable(ctx context.Context, region, typeID string) (bool, error) {
+	availability, err := c.ListRegionPlanAvailability(ctx, region)
+	if err != nil {
+		return false, err
+	}
+
+	return isTypeAvailable(availability, region, typeID), nil
+}
+
+func isTypeAvailable(availability []RegionAvailability, region, typeID string) bool {
+	for _, a := range availability {
+		if a.Region == region && a.Plan == typeID {
+			return a.Available
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,77 @@
+package linodego
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestClient_FilterRegionsByCapability(t *testing.T) {
+	ts, client := createTestServer(http.MethodGet, "/v4/regions", "application/json", `{"data": [
+		{"id": "us-east", "capabilities": ["Linodes", "Object Storage", "Kubernetes"]},
+		{"id": "us-west", "capabilities": ["Linodes", "Kubernetes"]},
+		{"id": "us-new", "capabilities": ["Linodes", "Object Storage", "Kubernetes", "Future Capability"]}
+	], "page": 1, "pages": 1, "results": 3}`, http.StatusOK)
+	defer ts.Close()
+
+	regions, err := client.FilterRegionsByCapability(context.Background(), CapabilityObjectStorage, CapabilityKubernetes)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(regions) != 2 || regions[0].ID != "us-east" || regions[1].ID != "us-new" {
+		t.Errorf("unexpected regions: %v", regions)
+	}
+
+	if !regions[1].HasCapability("Future Capability") || regions[0].HasCapability(CapabilityGPU) {
+		t.Errorf("unexpected capabilities: %v", regions[1].Capabilities)
+	}
+}
+
+func TestClient_IsTypeAvailable(t *testing.T) {
+	ts, client := createTestServer(http.MethodGet, "/v4/regions/us-east/availability", "application/json", `[
+		{"region": "us-east", "plan": "g7-premium-64", "available": false},
+		{"region": "us-east", "plan": "g7-premium-32", "available": true}
+	]`, http.StatusOK)
+	defer ts.Close()
+
+	for _, tc := range []struct {
+		region, typeID string
+		available      bool
+	}{
+		{"us-east", "g7-premium-64", false},
+		{"us-east", "g7-premium-32", true},
+		{"us-east", "g6-nanode-1", true},
+	} {
+		available, err := client.IsTypeAvailable(context.Background(), tc.region, tc.typeID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if available != tc.available {
+			t.Errorf("expected %s in %s to be available %t, got %t", tc.typeID, tc.region, tc.available, available)
+		}
+	}
+}
+
+func TestClient_ListRegionPlanAvailability_cache(t *testing.T) {
+	ts, client := createTestServer(http.MethodGet, "/v4/regions/us-east/availability", "application/json",
+		`[{"region": "us-east", "plan": "g7-premium-64", "available": false}]`, http.StatusOK)
+
+	client.UseCache(true)
+
+	if _, err := client.ListRegionPlanAvailability(context.Background(), "us-east"); err != nil {
+		t.Fatal(err)
+	}
+
+	ts.Close()
+
+	availability, err := client.ListRegionPlanAvailability(context.Background(), "us-east")
+	if err != nil {
+		t.Fatalf("expected the availability to be cached, got %v", err)
+	}
+
+	if len(availability) != 1 || availability[0].Plan != "g7-premium-64" || availability[0].Available {
+		t.Errorf("unexpected availability %+v", availability)
+	}
+}
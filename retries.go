@@ -1,10 +1,17 @@
 package linodego
 
 import (
+	"context"
 	"errors"
-	"log"
+	"fmt"
+	"io"
+	"math"
+	"math/rand"
+	"net"
 	"net/http"
 	"strconv"
+	"sync"
+	"syscall"
 	"time"
 
 	"github.com/go-resty/resty/v2"
@@ -14,6 +21,7 @@ import (
 const (
 	retryAfterHeaderName      = "Retry-After"
 	maintenanceModeHeaderName = "X-Maintenance-Mode"
+	requestIDHeaderName       = "X-Request-ID"
 )
 
 // type RetryConditional func(r *resty.Response) (shouldRetry bool)
@@ -22,22 +30,176 @@ type RetryConditional resty.RetryConditionFunc
 // type RetryAfter func(c *resty.Client, r *resty.Response) (time.Duration, error)
 type RetryAfter resty.RetryAfterFunc
 
+// RetryBackoff computes the delay before a retry when the API did not provide
+// a Retry-After header. attempt is 0 for the first retry and increases by one
+// for every subsequent retry of the same request.
+type RetryBackoff func(attempt int) time.Duration
+
 // Configures resty to
 // lock until enough time has passed to retry the request as determined by the Retry-After response header.
 // If the Retry-After header is not set, we fall back to value of SetPollDelay.
+// The retry count and max wait time are taken from the Client so values set through
+// SetRetryCount and SetRetryMaxWaitTime persist when retries are reconfigured.
+// Waits between attempts are aborted as soon as the request context is done,
+// in which case the context error is returned.
 func configureRetries(c *Client) {
 	c.resty.
-		SetRetryCount(1000).
+		SetRetryCount(c.retryCount).
+		SetRetryMaxWaitTime(c.retryMaxWaitTime).
 		AddRetryCondition(checkRetryConditionals(c)).
-		SetRetryAfter(respectRetryAfter)
+		SetRetryAfter(retryAfterWithBackoff(c.logger, c.retryBackoff))
+}
+
+// retryAfterWithBackoff returns a RetryAfter callback that respects the Retry-After
+// header when present and otherwise defers to the provided backoff, if any.
+func retryAfterWithBackoff(logger *clientLogger, backoff RetryBackoff) resty.RetryAfterFunc {
+	return func(client *resty.Client, resp *resty.Response) (time.Duration, error) {
+		if resp.Header().Get(retryAfterHeaderName) != "" || backoff == nil {
+			duration, err := respectRetryAfter(client, resp)
+			if err == nil && duration > 0 {
+				logger.Debugf("Respecting Retry-After Header of %s (%s) (max %s)",
+					resp.Header().Get(retryAfterHeaderName), duration, client.RetryMaxWaitTime)
+			}
+
+			return duration, err
+		}
+
+		attempt := 0
+		if resp.Request != nil && resp.Request.Attempt > 0 {
+			attempt = resp.Request.Attempt - 1
+		}
+
+		return backoff(attempt), nil
+	}
+}
+
+// exponentialBackoff returns a RetryBackoff that waits min * 2^attempt, capped at max,
+// with full jitter applied to the result. The wait is never 0, which resty would replace
+// with its own backoff.
+func exponentialBackoff(min, max time.Duration) RetryBackoff {
+	return func(attempt int) time.Duration {
+		capped := math.Min(float64(max), float64(min)*math.Exp2(float64(attempt)))
+		if capped < 1 {
+			return time.Nanosecond
+		}
+
+		return time.Duration(rand.Int63n(int64(capped))) + 1
+	}
+}
+
+// RetryCallback is invoked before a request is retried.
+// attempt is the attempt that triggered the retry and reason is the
+// name of the retry condition that matched.
+type RetryCallback func(resp *resty.Response, attempt int, reason string)
+
+// namedRetryConditional associates a RetryConditional with the name
+// reported to RetryCallback functions.
+type namedRetryConditional struct {
+	name      string
+	condition RetryConditional
+}
+
+// retryConditionals are the named retry conditionals of a Client, shared between its copies
+// so that conditionals added later, e.g. by SetTokenSource, are checked by the registered retry condition.
+type retryConditionals struct {
+	mu           sync.RWMutex
+	conditionals []namedRetryConditional
+}
+
+func (rc *retryConditionals) add(conditional namedRetryConditional) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	rc.conditionals = append(rc.conditionals, conditional)
+}
+
+// list returns the conditionals in the order they were added
+func (rc *retryConditionals) list() []namedRetryConditional {
+	if rc == nil {
+		return nil
+	}
+
+	rc.mu.RLock()
+	defer rc.mu.RUnlock()
+
+	return rc.conditionals[:len(rc.conditionals):len(rc.conditionals)]
+}
+
+// retryReason returns the name of the first conditional matching the response,
+// or "custom" if the retry was requested by a user-defined condition.
+func retryReason(conditionals []namedRetryConditional, r *resty.Response, err error) string {
+	for _, retryConditional := range conditionals {
+		if retryConditional.condition(r, err) {
+			return retryConditional.name
+		}
+	}
+
+	return "custom"
+}
+
+type retryPolicyContextKey struct{}
+
+// retryPolicy overrides the Client-level retry behavior for a single request.
+type retryPolicy struct {
+	disabled   bool
+	maxRetries *int
+}
+
+// WithRetryDisabled returns a copy of ctx which disables retries for
+// any request made with it, regardless of the Client retry configuration.
+func WithRetryDisabled(ctx context.Context) context.Context {
+	return context.WithValue(ctx, retryPolicyContextKey{}, retryPolicy{disabled: true})
+}
+
+// WithMaxRetries returns a copy of ctx which limits requests made with it
+// to at most maxRetries retries. This can only lower the limit set with SetRetryCount,
+// which bounds the retries of every request made by the Client.
+func WithMaxRetries(ctx context.Context, maxRetries int) context.Context {
+	return context.WithValue(ctx, retryPolicyContextKey{}, retryPolicy{maxRetries: &maxRetries})
+}
+
+// retryAllowedByContext reports whether the retry policy attached to the
+// request context, if any, allows another attempt.
+func retryAllowedByContext(r *resty.Response) bool {
+	if r == nil || r.Request == nil {
+		return true
+	}
+
+	policy, ok := r.Request.Context().Value(retryPolicyContextKey{}).(retryPolicy)
+	if !ok {
+		return true
+	}
+
+	if policy.disabled {
+		return false
+	}
+
+	// Attempt is 1 for the initial request, so the number of retries
+	// already performed is Attempt - 1.
+	return policy.maxRetries == nil || r.Request.Attempt <= *policy.maxRetries
 }
 
 func checkRetryConditionals(c *Client) func(*resty.Response, error) bool {
 	return func(r *resty.Response, err error) bool {
-		for _, retryConditional := range c.retryConditionals {
-			retry := retryConditional(r, err)
+		// There is no response when the request was aborted before being sent,
+		// e.g. by an OnBeforeRequest handler. These requests are never retried.
+		if r == nil || !retryAllowedByContext(r) {
+			return false
+		}
+
+		if r.StatusCode() == http.StatusServiceUnavailable && r.Header().Get(maintenanceModeHeaderName) != "" {
+			c.logger.Debugf("Linode API is under maintenance, request will not be retried - please see status.linode.com for more information")
+		}
+
+		for _, retryConditional := range c.retryConditionals.list() {
+			retry := retryConditional.condition(r, err)
 			if retry {
-				log.Printf("[INFO] Received error %s - Retrying", r.Error())
+				if requestID := responseRequestID(r); requestID != "" {
+					c.logger.Debugf("Received error %s - Retrying request %s", r.Error(), requestID)
+				} else {
+					c.logger.Debugf("Received error %s - Retrying", r.Error())
+				}
+
 				return true
 			}
 		}
@@ -45,6 +207,46 @@ func checkRetryConditionals(c *Client) func(*resty.Response, error) bool {
 	}
 }
 
+// responseRequestID returns the X-Request-ID of the response, falling back to the one sent with the request
+func responseRequestID(r *resty.Response) string {
+	if r.RawResponse != nil {
+		if requestID := r.RawResponse.Header.Get(requestIDHeaderName); requestID != "" {
+			return requestID
+		}
+	}
+
+	if r.Request != nil {
+		return r.Request.Header.Get(requestIDHeaderName)
+	}
+
+	return ""
+}
+
+type unauthorizedRetryContextKey struct{}
+
+// unauthorizedRetryCondition retries a request rejected with a 401 exactly once, discarding the
+// rejected token of the token source so that the retry is sent with a fresh one.
+// It never retries if the token source has no source to refresh the token from.
+func unauthorizedRetryCondition(tokenSource *clientTokenSource) RetryConditional {
+	return func(r *resty.Response, _ error) bool {
+		if r.StatusCode() != http.StatusUnauthorized || r.Request == nil || !tokenSource.refreshable() {
+			return false
+		}
+
+		ctx := r.Request.Context()
+		if attempt, ok := ctx.Value(unauthorizedRetryContextKey{}).(int); ok {
+			// The request was already retried with a fresh token, unless the condition
+			// is evaluated again for the same attempt, e.g. by retryReason
+			return attempt == r.Request.Attempt
+		}
+
+		tokenSource.invalidate(r.Request.Header.Get("Authorization"))
+		r.Request.SetContext(context.WithValue(ctx, unauthorizedRetryContextKey{}, r.Request.Attempt))
+
+		return true
+	}
+}
+
 // SetLinodeBusyRetry configures resty to retry specifically on "Linode busy." errors
 // The retry wait time is configured in SetPollDelay
 func linodeBusyRetryCondition(r *resty.Response, _ error) bool {
@@ -65,7 +267,6 @@ func serviceUnavailableRetryCondition(r *resty.Response, _ error) bool {
 	// an `X-MAINTENANCE-MODE` header. Don't retry during maintenance
 	// events, only for legitimate 503s.
 	if serviceUnavailable && r.Header().Get(maintenanceModeHeaderName) != "" {
-		log.Printf("[INFO] Linode API is under maintenance, request will not be retried - please see status.linode.com for more information")
 		return false
 	}
 
@@ -80,24 +281,75 @@ func requestGOAWAYRetryCondition(_ *resty.Response, e error) bool {
 	return errors.As(e, &http2.GoAwayError{})
 }
 
+// connectionFailureRetryCondition retries requests that failed before a response was
+// received due to transient network errors such as timeouts or reset connections.
+func connectionFailureRetryCondition(_ *resty.Response, err error) bool {
+	if err == nil {
+		return false
+	}
+
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) {
+		return dnsErr.IsTimeout || dnsErr.IsTemporary
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+
+	return errors.Is(err, io.EOF) ||
+		errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, syscall.ECONNRESET)
+}
+
+// isIdempotentRequest returns whether repeating the request of the response has the same
+// effect as making it once, so that it can be retried if it is unknown whether it reached the API.
+func isIdempotentRequest(r *resty.Response) bool {
+	if r == nil || r.Request == nil {
+		return false
+	}
+
+	switch r.Request.Method {
+	case http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete, http.MethodOptions:
+		return true
+	}
+
+	return false
+}
+
 func requestNGINXRetryCondition(r *resty.Response, _ error) bool {
 	return r.StatusCode() == http.StatusBadRequest &&
 		r.Header().Get("Server") == "nginx" &&
 		r.Header().Get("Content-Type") == "text/html"
 }
 
-func respectRetryAfter(client *resty.Client, resp *resty.Response) (time.Duration, error) {
+func respectRetryAfter(_ *resty.Client, resp *resty.Response) (time.Duration, error) {
 	retryAfterStr := resp.Header().Get(retryAfterHeaderName)
 	if retryAfterStr == "" {
 		return 0, nil
 	}
 
-	retryAfter, err := strconv.Atoi(retryAfterStr)
+	return parseRetryAfter(retryAfterStr, time.Now())
+}
+
+// parseRetryAfter parses the value of a Retry-After header, which may either be
+// a number of seconds or an HTTP-date (RFC 7231 section 7.1.3).
+// Dates in the past result in a zero duration.
+func parseRetryAfter(value string, now time.Time) (time.Duration, error) {
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return time.Duration(seconds) * time.Second, nil
+	}
+
+	retryAt, err := http.ParseTime(value)
 	if err != nil {
-		return 0, err
+		return 0, fmt.Errorf("failed to parse %s header %q: %w", retryAfterHeaderName, value, err)
+	}
+
+	duration := retryAt.Sub(now)
+	if duration < 0 {
+		duration = 0
 	}
 
-	duration := time.Duration(retryAfter) * time.Second
-	log.Printf("[INFO] Respecting Retry-After Header of %d (%s) (max %s)", retryAfter, duration, client.RetryMaxWaitTime)
 	return duration, nil
 }
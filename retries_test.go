@@ -1,7 +1,15 @@
 package linodego
 
 import (
+	"context"
+	"errors"
+	"io"
+	"net"
 	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"syscall"
 	"testing"
 	"time"
 
@@ -74,3 +82,360 @@ func TestLinodeServiceMaintenanceModeRetryCondition(t *testing.T) {
 		t.Error("expected retry to be skipped due to maintenance mode header")
 	}
 }
+
+func TestExponentialBackoff(t *testing.T) {
+	min := time.Second
+	max := 10 * time.Second
+	backoff := exponentialBackoff(min, max)
+
+	for attempt := 0; attempt < 10; attempt++ {
+		limit := min * time.Duration(1<<attempt)
+		if limit > max {
+			limit = max
+		}
+
+		for i := 0; i < 50; i++ {
+			if wait := backoff(attempt); wait <= 0 || wait > limit {
+				t.Fatalf("attempt %d: expected wait in (0, %s] but got %s", attempt, limit, wait)
+			}
+		}
+	}
+}
+
+func TestRetryAfterWithBackoff(t *testing.T) {
+	request := resty.Request{Attempt: 3}
+	rawResponse := http.Response{StatusCode: http.StatusTooManyRequests, Header: http.Header{}}
+	response := resty.Response{
+		Request:     &request,
+		RawResponse: &rawResponse,
+	}
+
+	var gotAttempt int
+	retryAfter := retryAfterWithBackoff(nil, func(attempt int) time.Duration {
+		gotAttempt = attempt
+		return 5 * time.Second
+	})
+
+	client := NewClient(nil)
+
+	if wait, err := retryAfter(client.resty, &response); err != nil {
+		t.Fatal(err)
+	} else if wait != 5*time.Second {
+		t.Errorf("expected backoff of 5s but got %s", wait)
+	}
+
+	if gotAttempt != 2 {
+		t.Errorf("expected attempt 2 but got %d", gotAttempt)
+	}
+
+	rawResponse.Header.Set(retryAfterHeaderName, "20")
+
+	if wait, err := retryAfter(client.resty, &response); err != nil {
+		t.Fatal(err)
+	} else if wait != 20*time.Second {
+		t.Errorf("expected Retry-After to take precedence but got %s", wait)
+	}
+}
+
+func TestClient_SetRetryBackoff_jitter(t *testing.T) {
+	var requests []time.Time
+
+	ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		requests = append(requests, time.Now())
+
+		rw.Header().Set("Content-Type", "application/json")
+		rw.WriteHeader(http.StatusTooManyRequests)
+		rw.Write([]byte(`{"errors": [{"reason": "Too many requests"}]}`))
+	}))
+	defer ts.Close()
+
+	client := NewClient(nil)
+	client.SetBaseURL(ts.URL)
+	client.SetRetryCount(20)
+	client.SetRetryBackoff(10*time.Millisecond, 10*time.Millisecond)
+
+	if _, err := client.GetInstance(context.Background(), 123); err == nil {
+		t.Fatal("expected an error once the retries are exhausted")
+	}
+
+	if len(requests) != 21 {
+		t.Fatalf("expected 21 requests, got %d", len(requests))
+	}
+
+	// Waits are spread below the base delay rather than raised to it
+	short := 0
+
+	for i := 1; i < len(requests); i++ {
+		if requests[i].Sub(requests[i-1]) < 5*time.Millisecond {
+			short++
+		}
+	}
+
+	if short == 0 {
+		t.Error("expected some waits to be shorter than half of the base delay")
+	}
+}
+
+func TestClient_SetRetryCountPersists(t *testing.T) {
+	client := NewClient(nil)
+
+	if client.resty.RetryCount != APIRetryCount {
+		t.Fatalf("expected default retry count %d but got %d", APIRetryCount, client.resty.RetryCount)
+	}
+
+	client.SetRetryCount(0).SetRetryMaxWaitTime(5 * time.Second)
+	client.SetRetries()
+
+	if client.resty.RetryCount != 0 {
+		t.Errorf("expected retry count 0 but got %d", client.resty.RetryCount)
+	}
+
+	if client.resty.RetryMaxWaitTime != 5*time.Second {
+		t.Errorf("expected max wait time 5s but got %s", client.resty.RetryMaxWaitTime)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2023, time.January, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		value    string
+		expected time.Duration
+		wantErr  bool
+	}{
+		{name: "seconds", value: "20", expected: 20 * time.Second},
+		{name: "http date", value: now.Add(90 * time.Second).Format(http.TimeFormat), expected: 90 * time.Second},
+		{name: "http date in the past", value: now.Add(-time.Minute).Format(http.TimeFormat), expected: 0},
+		{name: "malformed", value: "soon", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			duration, err := parseRetryAfter(tt.value, now)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error for %q", tt.value)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if duration != tt.expected {
+				t.Errorf("expected %s but got %s", tt.expected, duration)
+			}
+		})
+	}
+}
+
+func TestRespectRetryAfterMalformed(t *testing.T) {
+	request := resty.Request{}
+	rawResponse := http.Response{StatusCode: http.StatusServiceUnavailable, Header: http.Header{
+		retryAfterHeaderName: []string{"not-a-date"},
+	}}
+	response := resty.Response{
+		Request:     &request,
+		RawResponse: &rawResponse,
+	}
+
+	if _, err := respectRetryAfter(NewClient(nil).resty, &response); err == nil {
+		t.Error("expected error for malformed Retry-After header")
+	}
+}
+
+func TestCheckRetryConditionalsContextPolicy(t *testing.T) {
+	client := NewClient(nil)
+	check := checkRetryConditionals(&client)
+
+	newResponse := func(ctx context.Context, attempt int) *resty.Response {
+		request := client.R(ctx)
+		request.Attempt = attempt
+
+		return &resty.Response{
+			Request:     request,
+			RawResponse: &http.Response{StatusCode: http.StatusTooManyRequests},
+		}
+	}
+
+	if !check(newResponse(context.Background(), 1), nil) {
+		t.Error("expected request to be retried without a context policy")
+	}
+
+	if check(newResponse(WithRetryDisabled(context.Background()), 1), nil) {
+		t.Error("expected retries to be disabled by the context")
+	}
+
+	maxRetriesCtx := WithMaxRetries(context.Background(), 2)
+
+	if !check(newResponse(maxRetriesCtx, 2), nil) {
+		t.Error("expected second retry to be allowed")
+	}
+
+	if check(newResponse(maxRetriesCtx, 3), nil) {
+		t.Error("expected third retry to be rejected")
+	}
+}
+
+func TestWithMaxRetries_clientLimit(t *testing.T) {
+	requests := 0
+
+	ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		requests++
+
+		rw.Header().Set("Content-Type", "application/json")
+		rw.WriteHeader(http.StatusTooManyRequests)
+		rw.Write([]byte(`{"errors": [{"reason": "Too many requests"}]}`))
+	}))
+	defer ts.Close()
+
+	client := NewClient(nil)
+	client.SetBaseURL(ts.URL)
+	client.SetRetryWaitTime(time.Millisecond).SetRetryCount(2)
+
+	for _, tt := range []struct {
+		maxRetries int
+		expected   int
+	}{
+		{1, 2},
+		{5, 3}, // the retry count of the Client is not raised
+	} {
+		requests = 0
+
+		if _, err := client.GetInstance(WithMaxRetries(context.Background(), tt.maxRetries), 123); err == nil {
+			t.Fatal("expected an error once the retries are exhausted")
+		}
+
+		if requests != tt.expected {
+			t.Errorf("max retries %d: expected %d requests, got %d", tt.maxRetries, tt.expected, requests)
+		}
+	}
+}
+
+func TestRetryReason(t *testing.T) {
+	client := NewClient(nil)
+
+	tests := []struct {
+		statusCode int
+		expected   string
+	}{
+		{http.StatusTooManyRequests, "tooManyRequests"},
+		{http.StatusServiceUnavailable, "serviceUnavailable"},
+		{http.StatusRequestTimeout, "requestTimeout"},
+		{http.StatusTeapot, "custom"},
+	}
+
+	for _, tt := range tests {
+		response := resty.Response{
+			Request:     &resty.Request{},
+			RawResponse: &http.Response{StatusCode: tt.statusCode, Header: http.Header{}},
+		}
+
+		if reason := retryReason(client.retryConditionals.list(), &response, nil); reason != tt.expected {
+			t.Errorf("status %d: expected reason %q but got %q", tt.statusCode, tt.expected, reason)
+		}
+	}
+}
+
+type timeoutError struct{}
+
+func (timeoutError) Error() string   { return "i/o timeout" }
+func (timeoutError) Timeout() bool   { return true }
+func (timeoutError) Temporary() bool { return true }
+
+func TestConnectionFailureRetryCondition(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{name: "no error", err: nil, expected: false},
+		{name: "timeout", err: &url.Error{Op: "Get", URL: "https://api.linode.com", Err: timeoutError{}}, expected: true},
+		{name: "eof", err: &url.Error{Op: "Get", URL: "https://api.linode.com", Err: io.EOF}, expected: true},
+		{name: "connection reset", err: &net.OpError{Op: "read", Err: syscall.ECONNRESET}, expected: true},
+		{name: "dns not found", err: &net.DNSError{Err: "no such host", IsNotFound: true}, expected: false},
+		{name: "other", err: errors.New("something went wrong"), expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if retry := connectionFailureRetryCondition(nil, tt.err); retry != tt.expected {
+				t.Errorf("expected retry to be %t but got %t", tt.expected, retry)
+			}
+		})
+	}
+}
+
+func TestClient_SetRetryOnConnectionFailure(t *testing.T) {
+	client := NewClient(nil)
+	check := checkRetryConditionals(&client)
+
+	request := client.R(context.Background())
+	request.Method = http.MethodGet
+
+	response := &resty.Response{Request: request}
+
+	if !check(response, io.EOF) {
+		t.Error("expected connection failure to be retried by default")
+	}
+
+	client.SetRetryOnConnectionFailure(false)
+
+	if check(response, io.EOF) {
+		t.Error("expected connection failure not to be retried when disabled")
+	}
+}
+
+func TestClient_SetRetryNonIdempotentOnConnectionFailure(t *testing.T) {
+	client := NewClient(nil)
+	check := checkRetryConditionals(&client)
+
+	request := client.R(context.Background())
+	request.Method = http.MethodPost
+
+	response := &resty.Response{Request: request}
+
+	if check(response, io.EOF) {
+		t.Error("expected a POST not to be retried on connection failure by default")
+	}
+
+	client.SetRetryNonIdempotentOnConnectionFailure(true)
+
+	if !check(response, io.EOF) {
+		t.Error("expected a POST to be retried on connection failure when enabled")
+	}
+}
+
+func TestRetryWaitHonorsContextCancellation(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Set(retryAfterHeaderName, "10")
+		rw.Header().Set("Content-Type", "application/json")
+		rw.WriteHeader(http.StatusServiceUnavailable)
+		rw.Write([]byte(`{"errors": [{"reason": "Service unavailable"}]}`))
+	}))
+	defer ts.Close()
+
+	client := NewClient(nil)
+	client.SetBaseURL(ts.URL)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(100*time.Millisecond, cancel)
+
+	start := time.Now()
+	_, err := client.GetInstance(ctx, 123)
+	elapsed := time.Since(start)
+
+	if err == nil {
+		t.Fatal("expected an error after the context was cancelled")
+	}
+
+	if !strings.Contains(err.Error(), context.Canceled.Error()) {
+		t.Errorf("expected context cancellation error but got %s", err)
+	}
+
+	if elapsed > 2*time.Second {
+		t.Errorf("expected request to return promptly after cancellation but took %s", elapsed)
+	}
+}
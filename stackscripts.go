@@ -3,6 +3,7 @@ package linodego
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"time"
 
@@ -10,7 +11,9 @@ import (
 	"github.com/linode/linodego/internal/parseabletime"
 )
 
-// Stackscript represents a Linode StackScript
+// Stackscript represents a Linode StackScript.
+// RevNote describes the current revision of the Script, which was made at Updated.
+// The API does not keep a history of previous revisions.
 type Stackscript struct {
 	ID                int               `json:"id"`
 	Username          string            `json:"username"`
@@ -155,7 +158,7 @@ func (c *Client) GetStackscript(ctx context.Context, scriptID int) (*Stackscript
 
 // CreateStackscript creates a StackScript
 func (c *Client) CreateStackscript(ctx context.Context, opts StackscriptCreateOptions) (*Stackscript, error) {
-	body, err := json.Marshal(opts)
+	body, err := c.marshalJSON(opts)
 	if err != nil {
 		return nil, err
 	}
@@ -171,7 +174,36 @@ func (c *Client) CreateStackscript(ctx context.Context, opts StackscriptCreateOp
 
 // UpdateStackscript updates the StackScript with the specified id
 func (c *Client) UpdateStackscript(ctx context.Context, scriptID int, opts StackscriptUpdateOptions) (*Stackscript, error) {
-	body, err := json.Marshal(opts)
+	body, err := c.marshalJSON(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	req := c.R(ctx).SetResult(&Stackscript{}).SetBody(string(body))
+	e := fmt.Sprintf("linode/stackscripts/%d", scriptID)
+	r, err := coupleAPIErrors(req.Put(e))
+	if err != nil {
+		return nil, err
+	}
+	return r.Result().(*Stackscript), nil
+}
+
+// stackscriptRevisionOptions are the fields sent by UpdateStackscriptWithRevision
+type stackscriptRevisionOptions struct {
+	RevNote string `json:"rev_note"`
+	Script  string `json:"script"`
+}
+
+// UpdateStackscriptWithRevision replaces the script of the StackScript with the specified id,
+// describing the change with revNote. Unlike UpdateStackscript, all other fields are left untouched.
+// The API does not keep previous revisions, so callers that need to roll back must retain the
+// previous Script, e.g. from GetStackscript, before updating it.
+func (c *Client) UpdateStackscriptWithRevision(ctx context.Context, scriptID int, script, revNote string) (*Stackscript, error) {
+	if script == "" {
+		return nil, errors.New("script is required")
+	}
+
+	body, err := c.marshalJSON(stackscriptRevisionOptions{RevNote: revNote, Script: script})
 	if err != nil {
 		return nil, err
 	}
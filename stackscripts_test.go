@@ -0,0 +1,42 @@
+package linodego
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestClient_UpdateStackscriptWithRevision(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Path != "/v4/linode/stackscripts/123" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+
+		body, _ := io.ReadAll(r.Body)
+		if string(body) != `{"rev_note":"Install nginx","script":"#!/bin/bash\napt-get install -y nginx"}` {
+			t.Errorf("unexpected body %s", body)
+		}
+
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(`{"id": 123, "label": "web", "rev_note": "Install nginx", "script": "#!/bin/bash\napt-get install -y nginx", "updated": "2024-01-02T03:04:05"}`))
+	}))
+	defer ts.Close()
+
+	client := NewClient(nil)
+	client.SetBaseURL(ts.URL)
+
+	script, err := client.UpdateStackscriptWithRevision(context.Background(), 123, "#!/bin/bash\napt-get install -y nginx", "Install nginx")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if script.Label != "web" || script.RevNote != "Install nginx" || script.Updated == nil {
+		t.Errorf("unexpected stackscript %+v", script)
+	}
+
+	if _, err := client.UpdateStackscriptWithRevision(context.Background(), 123, "", "Empty"); err == nil {
+		t.Error("expected an error for an empty script")
+	}
+}
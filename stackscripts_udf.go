@@ -0,0 +1,198 @@
+package linodego
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+var (
+	stackscriptUDFRegexp          = regexp.MustCompile(`(?i)<UDF\s([^>]*)>`)
+	stackscriptUDFAttributeRegexp = regexp.MustCompile(`([A-Za-z]+)\s*=\s*(?:"([^"]*)"|'([^']*)')`)
+)
+
+// StackscriptDataError is returned by ValidateStackscriptData when the data passed to a
+// StackScript does not match its user defined fields
+type StackscriptDataError struct {
+	// MissingFields are the names of required fields without a value
+	MissingFields []string
+
+	// UnknownFields are the names of values that do not match a field
+	UnknownFields []string
+
+	// InvalidFields are the names of fields with a value that is not one of the accepted values
+	InvalidFields []string
+}
+
+func (e *StackscriptDataError) Error() string {
+	var problems []string
+
+	if len(e.MissingFields) > 0 {
+		problems = append(problems, "missing required fields: "+strings.Join(e.MissingFields, ", "))
+	}
+
+	if len(e.UnknownFields) > 0 {
+		problems = append(problems, "unknown fields: "+strings.Join(e.UnknownFields, ", "))
+	}
+
+	if len(e.InvalidFields) > 0 {
+		problems = append(problems, "fields with invalid values: "+strings.Join(e.InvalidFields, ", "))
+	}
+
+	return "invalid StackScript data: " + strings.Join(problems, "; ")
+}
+
+// stackscriptField is a user defined field and whether it has a default value
+type stackscriptField struct {
+	StackscriptUDF
+	hasDefault bool
+}
+
+// ParseUDFs extracts the user defined fields declared by <UDF> tags in the Script,
+// e.g. <UDF name="hostname" label="The hostname" default="localhost" />.
+func (i Stackscript) ParseUDFs() ([]StackscriptUDF, error) {
+	fields, err := parseStackscriptFields(i.Script)
+	if err != nil {
+		return nil, err
+	}
+
+	udfs := make([]StackscriptUDF, len(fields))
+	for n, field := range fields {
+		udfs[n] = field.StackscriptUDF
+	}
+
+	return udfs, nil
+}
+
+func parseStackscriptFields(script string) ([]stackscriptField, error) {
+	var fields []stackscriptField
+
+	for _, tag := range stackscriptUDFRegexp.FindAllStringSubmatch(script, -1) {
+		var field stackscriptField
+
+		for _, attribute := range stackscriptUDFAttributeRegexp.FindAllStringSubmatch(tag[1], -1) {
+			value := attribute[2] + attribute[3]
+
+			switch strings.ToLower(attribute[1]) {
+			case "name":
+				field.Name = value
+			case "label":
+				field.Label = value
+			case "example":
+				field.Example = value
+			case "oneof":
+				field.OneOf = value
+			case "manyof":
+				field.ManyOf = value
+			case "default":
+				field.Default = value
+				field.hasDefault = true
+			}
+		}
+
+		if field.Name == "" {
+			return nil, fmt.Errorf("UDF tag %q does not have a name", tag[0])
+		}
+
+		fields = append(fields, field)
+	}
+
+	return fields, nil
+}
+
+// ValidateStackscriptData checks that data, as passed in InstanceCreateOptions.StackScriptData,
+// provides a value for every required user defined field of the StackScript, does not contain
+// unknown fields, and only uses accepted values for oneOf and manyOf fields.
+// The fields are parsed from the Script if it is set, otherwise the UserDefinedFields are used
+// and fields without a default are considered required.
+// A *StackscriptDataError is returned if the data does not match.
+func ValidateStackscriptData(script *Stackscript, data map[string]string) error {
+	var fields []stackscriptField
+
+	if script.Script != "" {
+		var err error
+		if fields, err = parseStackscriptFields(script.Script); err != nil {
+			return err
+		}
+	} else if script.UserDefinedFields != nil {
+		for _, udf := range *script.UserDefinedFields {
+			fields = append(fields, stackscriptField{StackscriptUDF: udf, hasDefault: udf.Default != ""})
+		}
+	}
+
+	var dataErr StackscriptDataError
+
+	known := make(map[string]bool, len(fields))
+
+	for _, field := range fields {
+		known[field.Name] = true
+
+		value, ok := data[field.Name]
+		if !ok {
+			if !field.hasDefault {
+				dataErr.MissingFields = append(dataErr.MissingFields, field.Name)
+			}
+
+			continue
+		}
+
+		if !stackscriptValueAccepted(field.StackscriptUDF, value) {
+			dataErr.InvalidFields = append(dataErr.InvalidFields, field.Name)
+		}
+	}
+
+	for name := range data {
+		if !known[name] {
+			dataErr.UnknownFields = append(dataErr.UnknownFields, name)
+		}
+	}
+
+	if len(dataErr.MissingFields) == 0 && len(dataErr.UnknownFields) == 0 && len(dataErr.InvalidFields) == 0 {
+		return nil
+	}
+
+	sort.Strings(dataErr.UnknownFields)
+
+	return &dataErr
+}
+
+// stackscriptValueAccepted reports whether value is accepted by the oneOf or manyOf list of the field.
+func stackscriptValueAccepted(udf StackscriptUDF, value string) bool {
+	switch {
+	case udf.OneOf != "":
+		return containsString(splitStackscriptList(udf.OneOf), value)
+	case udf.ManyOf != "":
+		if value == "" {
+			return true
+		}
+
+		accepted := splitStackscriptList(udf.ManyOf)
+		for _, v := range splitStackscriptList(value) {
+			if !containsString(accepted, v) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+func splitStackscriptList(list string) []string {
+	values := strings.Split(list, ",")
+	for i, value := range values {
+		values[i] = strings.TrimSpace(value)
+	}
+
+	return values
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,61 @@
+package linodego
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+const testStackscriptBody = `#!/bin/bash
+# <UDF name="hostname" label="The hostname" example="example.com" />
+# <UDF name="db_engine" label="Database" oneOf="mysql,postgresql" default="mysql" />
+# <UDF name="packages" Label='Extra packages' manyOf="git,vim,curl" default="">
+echo "$HOSTNAME"
+`
+
+func TestStackscript_ParseUDFs(t *testing.T) {
+	udfs, err := Stackscript{Script: testStackscriptBody}.ParseUDFs()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []StackscriptUDF{
+		{Name: "hostname", Label: "The hostname", Example: "example.com"},
+		{Name: "db_engine", Label: "Database", OneOf: "mysql,postgresql", Default: "mysql"},
+		{Name: "packages", Label: "Extra packages", ManyOf: "git,vim,curl"},
+	}
+
+	if !reflect.DeepEqual(udfs, expected) {
+		t.Errorf("expected %#v, got %#v", expected, udfs)
+	}
+
+	if _, err := (Stackscript{Script: `# <UDF label="no name" />`}).ParseUDFs(); err == nil {
+		t.Error("expected an error for a UDF without a name")
+	}
+}
+
+func TestValidateStackscriptData(t *testing.T) {
+	script := &Stackscript{Script: testStackscriptBody}
+
+	if err := ValidateStackscriptData(script, map[string]string{"hostname": "web", "packages": "git,vim"}); err != nil {
+		t.Errorf("expected the data to be valid, got %v", err)
+	}
+
+	err := ValidateStackscriptData(script, map[string]string{"hostnme": "web", "db_engine": "redis"})
+
+	var dataErr *StackscriptDataError
+	if !errors.As(err, &dataErr) {
+		t.Fatalf("expected a StackscriptDataError, got %v", err)
+	}
+
+	expected := "invalid StackScript data: missing required fields: hostname; unknown fields: hostnme; fields with invalid values: db_engine"
+	if err.Error() != expected {
+		t.Errorf("expected %q, got %q", expected, err.Error())
+	}
+
+	// Without a script body, fields without a default are required
+	fromAPI := &Stackscript{UserDefinedFields: &[]StackscriptUDF{{Name: "hostname"}, {Name: "user", Default: "root"}}}
+	if err := ValidateStackscriptData(fromAPI, map[string]string{}); err == nil || err.Error() != "invalid StackScript data: missing required fields: hostname" {
+		t.Errorf("unexpected error %v", err)
+	}
+}
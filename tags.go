@@ -22,7 +22,7 @@ type TaggedObject struct {
 	Data    any             `json:"-"`
 }
 
-// SortedObjects currently only includes Instances
+// SortedObjects are the tagged objects of a Tag, by type
 type SortedObjects struct {
 	Instances     []Instance
 	LKEClusters   []LKECluster
@@ -34,6 +34,14 @@ type SortedObjects struct {
 	*/
 }
 
+// TaggedRef references a taggable resource by the Type used in TaggedObject and its ID,
+// e.g. TaggedRef{Type: "linode", ID: 123}. The supported types are "linode", "volume",
+// "domain", "nodebalancer" and "lke_cluster".
+type TaggedRef struct {
+	Type string
+	ID   int
+}
+
 // TaggedObjectList are a list of TaggedObjects, as returning by ListTaggedObjects
 type TaggedObjectList []TaggedObject
 
@@ -148,7 +156,6 @@ func (i *TaggedObject) fixData() (*TaggedObject, error) {
 // ListTaggedObjects lists Tagged Objects
 func (c *Client) ListTaggedObjects(ctx context.Context, label string, opts *ListOptions) (TaggedObjectList, error) {
 	response := TaggedObjectsPagedResponse{}
-	label = url.PathEscape(label)
 	err := c.listHelper(ctx, &response, opts, label)
 	if err != nil {
 		return nil, err
@@ -162,6 +169,27 @@ func (c *Client) ListTaggedObjects(ctx context.Context, label string, opts *List
 	return response.Data, nil
 }
 
+// GetTaggedObjects gets all objects tagged with the provided label, by type
+func (c *Client) GetTaggedObjects(ctx context.Context, label string) (*SortedObjects, error) {
+	objects, err := c.ListTaggedObjects(ctx, label, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	sorted, err := objects.SortedObjects()
+	if err != nil {
+		return nil, err
+	}
+
+	return &sorted, nil
+}
+
+// ListInstancesByTag lists the Linode instances tagged with the provided tag.
+// The instances are filtered by the API, so only the tagged instances are fetched.
+func (c *Client) ListInstancesByTag(ctx context.Context, tag string) ([]Instance, error) {
+	return c.ListInstances(ctx, NewListOptions(0, NewFilter().Eq("tags", tag).String()))
+}
+
 // SortedObjects converts a list of TaggedObjects into a Sorted Objects struct, for easier access
 func (t TaggedObjectList) SortedObjects() (SortedObjects, error) {
 	so := SortedObjects{}
@@ -205,7 +233,7 @@ func (t TaggedObjectList) SortedObjects() (SortedObjects, error) {
 
 // CreateTag creates a Tag
 func (c *Client) CreateTag(ctx context.Context, opts TagCreateOptions) (*Tag, error) {
-	body, err := json.Marshal(opts)
+	body, err := c.marshalJSON(opts)
 	if err != nil {
 		return nil, err
 	}
@@ -226,3 +254,159 @@ func (c *Client) DeleteTag(ctx context.Context, label string) error {
 	_, err := coupleAPIErrors(c.R(ctx).Delete(e))
 	return err
 }
+
+// AddTagToResources applies the tag to all of the referenced resources. If the tag does not
+// exist yet, it is created and applied to the resources with a single CreateTag call.
+// Otherwise each resource is updated individually. Failures to tag individual resources do not
+// stop the remaining resources from being tagged and are returned joined together.
+func (c *Client) AddTagToResources(ctx context.Context, tag string, refs []TaggedRef) error {
+	tags, err := c.ListTags(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	exists := false
+	for _, t := range tags {
+		exists = exists || t.Label == tag
+	}
+
+	if !exists {
+		opts, err := taggedRefsCreateOptions(tag, refs)
+		if err != nil {
+			return err
+		}
+
+		_, err = c.CreateTag(ctx, opts)
+		return err
+	}
+
+	return c.updateResourcesTags(ctx, refs, func(tags []string) ([]string, bool) {
+		for _, t := range tags {
+			if t == tag {
+				return tags, false
+			}
+		}
+
+		return append(tags, tag), true
+	})
+}
+
+// RemoveTagFromResources removes the tag from all of the referenced resources, updating each
+// resource individually. Failures to untag individual resources do not stop the remaining
+// resources from being untagged and are returned joined together.
+// The tag itself is not deleted, see DeleteTag.
+func (c *Client) RemoveTagFromResources(ctx context.Context, tag string, refs []TaggedRef) error {
+	return c.updateResourcesTags(ctx, refs, func(tags []string) ([]string, bool) {
+		result := make([]string, 0, len(tags))
+		for _, t := range tags {
+			if t != tag {
+				result = append(result, t)
+			}
+		}
+
+		return result, len(result) != len(tags)
+	})
+}
+
+// taggedRefsCreateOptions returns the TagCreateOptions applying the tag to the referenced resources
+func taggedRefsCreateOptions(tag string, refs []TaggedRef) (TagCreateOptions, error) {
+	opts := TagCreateOptions{Label: tag}
+
+	for _, ref := range refs {
+		switch ref.Type {
+		case "linode":
+			opts.Linodes = append(opts.Linodes, ref.ID)
+		case "lke_cluster":
+			opts.LKEClusters = append(opts.LKEClusters, ref.ID)
+		case "domain":
+			opts.Domains = append(opts.Domains, ref.ID)
+		case "volume":
+			opts.Volumes = append(opts.Volumes, ref.ID)
+		case "nodebalancer":
+			opts.NodeBalancers = append(opts.NodeBalancers, ref.ID)
+		default:
+			return opts, fmt.Errorf("unsupported tagged object type %q", ref.Type)
+		}
+	}
+
+	return opts, nil
+}
+
+// updateResourcesTags replaces the tags of each referenced resource with the result of update,
+// skipping the resources for which update reports no change.
+func (c *Client) updateResourcesTags(ctx context.Context, refs []TaggedRef, update func([]string) ([]string, bool)) error {
+	var errs []error
+
+	for _, ref := range refs {
+		if err := c.updateResourceTags(ctx, ref, update); err != nil {
+			errs = append(errs, fmt.Errorf("failed to update the tags of %s %d: %w", ref.Type, ref.ID, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (c *Client) updateResourceTags(ctx context.Context, ref TaggedRef, update func([]string) ([]string, bool)) error {
+	switch ref.Type {
+	case "linode":
+		instance, err := c.GetInstance(ctx, ref.ID)
+		if err != nil {
+			return err
+		}
+
+		if tags, changed := update(instance.Tags); changed {
+			_, err = c.UpdateInstance(ctx, ref.ID, InstanceUpdateOptions{Tags: &tags})
+		}
+
+		return err
+	case "lke_cluster":
+		cluster, err := c.GetLKECluster(ctx, ref.ID)
+		if err != nil {
+			return err
+		}
+
+		if tags, changed := update(cluster.Tags); changed {
+			_, err = c.UpdateLKECluster(ctx, ref.ID, LKEClusterUpdateOptions{Tags: &tags})
+		}
+
+		return err
+	case "domain":
+		domain, err := c.GetDomain(ctx, ref.ID)
+		if err != nil {
+			return err
+		}
+
+		if tags, changed := update(domain.Tags); changed {
+			// DomainUpdateOptions always sends all of its fields, so start from the current Domain
+			opts := domain.GetUpdateOptions()
+			opts.Tags = tags
+			_, err = c.UpdateDomain(ctx, ref.ID, opts)
+		}
+
+		return err
+	case "volume":
+		volume, err := c.GetVolume(ctx, ref.ID)
+		if err != nil {
+			return err
+		}
+
+		if tags, changed := update(volume.Tags); changed {
+			_, err = c.UpdateVolume(ctx, ref.ID, VolumeUpdateOptions{Tags: &tags})
+		}
+
+		return err
+	case "nodebalancer":
+		nodebalancer, err := c.GetNodeBalancer(ctx, ref.ID)
+		if err != nil {
+			return err
+		}
+
+		if tags, changed := update(nodebalancer.Tags); changed {
+			_, err = c.UpdateNodeBalancer(ctx, ref.ID, NodeBalancerUpdateOptions{Tags: &tags})
+		}
+
+		return err
+	default:
+		return fmt.Errorf("unsupported tagged object type %q", ref.Type)
+	}
+}
@@ -0,0 +1,138 @@
+package linodego
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestClient_GetTaggedObjects(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		if r.URL.EscapedPath() != "/v4/tags/env:prod%20eu" {
+			t.Errorf("unexpected path %s", r.URL.EscapedPath())
+		}
+
+		rw.Header().Set("Content-Type", "application/json")
+
+		if r.URL.Query().Get("page") == "2" {
+			rw.Write([]byte(`{"data": [{"type": "volume", "data": {"id": 3, "label": "data"}}], "page": 2, "pages": 2, "results": 3}`))
+			return
+		}
+
+		rw.Write([]byte(`{"data": [
+			{"type": "linode", "data": {"id": 1, "label": "web"}},
+			{"type": "domain", "data": {"id": 2, "domain": "example.com"}}
+		], "page": 1, "pages": 2, "results": 3}`))
+	}))
+	defer ts.Close()
+
+	client := NewClient(nil)
+	client.SetBaseURL(ts.URL)
+
+	objects, err := client.GetTaggedObjects(context.Background(), "env:prod eu")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(objects.Instances) != 1 || objects.Instances[0].Label != "web" ||
+		len(objects.Domains) != 1 || len(objects.Volumes) != 1 || objects.Volumes[0].ID != 3 {
+		t.Errorf("unexpected tagged objects %+v", objects)
+	}
+}
+
+func TestClient_ListInstancesByTag(t *testing.T) {
+	ts, client := createTestServer(http.MethodGet, "/v4/linode/instances", "application/json",
+		`{"data": [{"id": 1, "tags": ["env:prod"]}], "page": 1, "pages": 1, "results": 1}`, http.StatusOK)
+	defer ts.Close()
+
+	client.OnBeforeRequest(func(r *Request) error {
+		if filter := r.Header.Get("X-Filter"); filter != `{"tags":"env:prod"}` {
+			t.Errorf("unexpected filter %s", filter)
+		}
+
+		return nil
+	})
+
+	instances, err := client.ListInstancesByTag(context.Background(), "env:prod")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(instances) != 1 || instances[0].ID != 1 {
+		t.Errorf("unexpected instances %v", instances)
+	}
+}
+
+func TestClient_AddTagToResources(t *testing.T) {
+	tagExists := false
+	var updates []string
+
+	ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Set("Content-Type", "application/json")
+		body, _ := io.ReadAll(r.Body)
+
+		switch r.Method + " " + r.URL.Path {
+		case "GET /v4/tags":
+			if tagExists {
+				rw.Write([]byte(`{"data": [{"label": "team:web"}], "page": 1, "pages": 1, "results": 1}`))
+				return
+			}
+
+			rw.Write([]byte(`{"data": [], "page": 1, "pages": 1, "results": 0}`))
+		case "POST /v4/tags":
+			if string(body) != `{"label":"team:web","linodes":[1],"volumes":[2]}` {
+				t.Errorf("unexpected body %s", body)
+			}
+
+			rw.Write(body)
+		case "GET /v4/linode/instances/1":
+			rw.Write([]byte(`{"id": 1, "tags": ["env:prod", "team:web"]}`))
+		case "GET /v4/volumes/2":
+			rw.Write([]byte(`{"id": 2, "tags": ["env:prod"]}`))
+		case "PUT /v4/linode/instances/1", "PUT /v4/volumes/2":
+			updates = append(updates, r.URL.Path+" "+string(body))
+			rw.Write([]byte(`{}`))
+		default:
+			rw.WriteHeader(http.StatusNotFound)
+			rw.Write([]byte(`{"errors": [{"reason": "Not found"}]}`))
+		}
+	}))
+	defer ts.Close()
+
+	client := NewClient(nil)
+	client.SetBaseURL(ts.URL)
+
+	refs := []TaggedRef{{Type: "linode", ID: 1}, {Type: "volume", ID: 2}}
+
+	if err := client.AddTagToResources(context.Background(), "team:web", refs); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(updates) != 0 {
+		t.Errorf("expected a new tag to be applied when creating it, got updates %v", updates)
+	}
+
+	tagExists = true
+
+	err := client.AddTagToResources(context.Background(), "team:web", append(refs, TaggedRef{Type: "volume", ID: 3}))
+	if err == nil || !strings.Contains(err.Error(), "volume 3") {
+		t.Errorf("expected an error for the missing volume, got %v", err)
+	}
+
+	if len(updates) != 1 || updates[0] != `/v4/volumes/2 {"tags":["env:prod","team:web"]}` {
+		t.Errorf("expected only the untagged volume to be updated, got %v", updates)
+	}
+
+	updates = nil
+
+	if err := client.RemoveTagFromResources(context.Background(), "team:web", refs); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(updates) != 1 || updates[0] != `/v4/linode/instances/1 {"tags":["env:prod"]}` {
+		t.Errorf("expected only the tagged instance to be updated, got %v", updates)
+	}
+}
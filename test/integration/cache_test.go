@@ -23,6 +23,8 @@ func TestCache_RegionList(t *testing.T) {
 	client, teardown := createTestClient(t, "fixtures/TestCache_RegionList")
 	defer teardown()
 
+	client.UseCache(true)
+
 	// Collect request number
 	totalRequests := int64(0)
 
@@ -89,6 +91,8 @@ func TestCache_Expiration(t *testing.T) {
 	client, teardown := createTestClient(t, "fixtures/TestCache_Expiration")
 	defer teardown()
 
+	client.UseCache(true)
+
 	// Collect request number
 	totalRequests := int64(0)
 
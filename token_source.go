@@ -0,0 +1,102 @@
+package linodego
+
+import (
+	"fmt"
+	"sync"
+
+	"golang.org/x/oauth2"
+)
+
+// clientTokenSource provides the tokens of a Client set with SetTokenSource.
+// It is shared between copies of the Client.
+type clientTokenSource struct {
+	mu     sync.Mutex
+	source oauth2.TokenSource
+	token  *oauth2.Token
+}
+
+// setSource replaces the source of the tokens, discarding the current token
+func (s *clientTokenSource) setSource(source oauth2.TokenSource) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.source = source
+	s.token = nil
+}
+
+// refreshable reports whether a source is set to fetch new tokens from
+func (s *clientTokenSource) refreshable() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.source != nil
+}
+
+// authorization returns the Authorization header for a request, fetching a new
+// token if there is none or it has expired. It returns "" if no source is set.
+func (s *clientTokenSource) authorization() (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.source == nil {
+		return "", nil
+	}
+
+	if !s.token.Valid() {
+		token, err := s.source.Token()
+		if err != nil {
+			return "", fmt.Errorf("failed to get token: %w", err)
+		}
+
+		s.token = token
+	}
+
+	return s.token.Type() + " " + s.token.AccessToken, nil
+}
+
+// invalidate discards the current token if it is the one sent with the given Authorization
+// header, so that the next request fetches a new one. Tokens already replaced are ignored.
+func (s *clientTokenSource) invalidate(authorization string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token != nil && authorization == s.token.Type()+" "+s.token.AccessToken {
+		s.token = nil
+	}
+}
+
+// requestAuthorizationContextKey marks requests sending their own Authorization header
+type requestAuthorizationContextKey struct{}
+
+// SetTokenSource sets the source of the OAuth tokens sent with all requests from this client.
+// A new token is fetched before a request when the current one has expired, and a request
+// rejected with a 401 is retried exactly once with a fresh token; a second 401 is returned
+// as an error. The token source takes precedence over SetToken; pass nil to remove it.
+func (c *Client) SetTokenSource(source oauth2.TokenSource) *Client {
+	if c.tokenSource == nil {
+		tokenSource := &clientTokenSource{}
+		c.tokenSource = tokenSource
+
+		c.OnBeforeRequest(func(request *Request) error {
+			// Requests authorized with another token, e.g. by QueryMonitorMetrics, keep it
+			if request.Context().Value(requestAuthorizationContextKey{}) != nil {
+				return nil
+			}
+
+			authorization, err := tokenSource.authorization()
+			if err != nil || authorization == "" {
+				return err
+			}
+
+			request.SetHeader("Authorization", authorization)
+
+			return nil
+		})
+
+		c.addRetryConditional("unauthorized", unauthorizedRetryCondition(tokenSource))
+	}
+
+	c.tokenSource.setSource(source)
+
+	return c
+}
@@ -0,0 +1,117 @@
+// Package tracing provides OpenTelemetry tracing for linodego clients.
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/linode/linodego"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	retryAfterHeaderName      = "Retry-After"
+	maintenanceModeHeaderName = "X-Maintenance-Mode"
+)
+
+type spanContextKey struct{}
+
+// Instrument configures client to create a span with tracer for every API request.
+// A single span covers all retry attempts of a request; each retry is recorded as
+// an event on the span and the total number of attempts is recorded when the request finishes.
+// Spans are marked as errors when the request fails or the API responds with a non-2xx status.
+func Instrument(client *linodego.Client, tracer trace.Tracer) {
+	client.OnBeforeRequest(func(request *linodego.Request) error {
+		if span, ok := request.Context().Value(spanContextKey{}).(trace.Span); ok {
+			span.AddEvent("retry", trace.WithAttributes(
+				attribute.Int("linodego.attempt", request.Attempt),
+			))
+
+			return nil
+		}
+
+		ctx, span := tracer.Start(
+			request.Context(),
+			fmt.Sprintf("linodego %s", request.Method),
+			trace.WithSpanKind(trace.SpanKindClient),
+			trace.WithAttributes(
+				attribute.String("http.request.method", request.Method),
+				attribute.String("url.full", request.URL),
+			),
+		)
+
+		request.SetContext(context.WithValue(ctx, spanContextKey{}, span))
+
+		return nil
+	})
+
+	client.OnAfterResponse(func(response *linodego.Response) error {
+		span, ok := response.Request.Context().Value(spanContextKey{}).(trace.Span)
+		if !ok {
+			return nil
+		}
+
+		attrs := []attribute.KeyValue{
+			attribute.Int("http.response.status_code", response.StatusCode()),
+			attribute.Int("linodego.attempt", response.Request.Attempt),
+		}
+
+		if retryAfter := response.Header().Get(retryAfterHeaderName); retryAfter != "" {
+			attrs = append(attrs, attribute.String("http.response.header.retry_after", retryAfter))
+		}
+
+		if maintenance := response.Header().Get(maintenanceModeHeaderName); maintenance != "" {
+			attrs = append(attrs, attribute.String("http.response.header.x_maintenance_mode", maintenance))
+		}
+
+		span.AddEvent("response", trace.WithAttributes(attrs...))
+
+		return nil
+	})
+
+	client.OnRequestFinished(func(request *linodego.Request, response *linodego.Response, err error) {
+		span, ok := request.Context().Value(spanContextKey{}).(trace.Span)
+		if !ok {
+			return
+		}
+
+		defer span.End()
+
+		attempts := request.Attempt
+		if attempts < 1 {
+			attempts = 1
+		}
+
+		span.SetAttributes(
+			attribute.Int("linodego.attempts", attempts),
+			attribute.Int("linodego.retries", attempts-1),
+		)
+
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+
+		if response == nil || response.RawResponse == nil {
+			return
+		}
+
+		statusCode := response.StatusCode()
+		span.SetAttributes(attribute.Int("http.response.status_code", statusCode))
+
+		if retryAfter := response.Header().Get(retryAfterHeaderName); retryAfter != "" {
+			span.SetAttributes(attribute.String("http.response.header.retry_after", retryAfter))
+		}
+
+		if maintenance := response.Header().Get(maintenanceModeHeaderName); maintenance != "" {
+			span.SetAttributes(attribute.String("http.response.header.x_maintenance_mode", maintenance))
+		}
+
+		if err == nil && (statusCode < 200 || statusCode >= 300) {
+			span.SetStatus(codes.Error, http.StatusText(statusCode))
+		}
+	})
+}
@@ -0,0 +1,83 @@
+package tracing
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/linode/linodego"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestInstrument(t *testing.T) {
+	requests := 0
+
+	ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		requests++
+
+		rw.Header().Set("Content-Type", "application/json")
+
+		if requests == 1 {
+			rw.Header().Set(retryAfterHeaderName, "0")
+			rw.WriteHeader(http.StatusTooManyRequests)
+			rw.Write([]byte(`{"errors": [{"reason": "Too many requests"}]}`))
+
+			return
+		}
+
+		rw.WriteHeader(http.StatusNotFound)
+		rw.Write([]byte(`{"errors": [{"reason": "Not found"}]}`))
+	}))
+	defer ts.Close()
+
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+
+	client := linodego.NewClient(nil)
+	client.SetBaseURL(ts.URL)
+	client.SetRetryWaitTime(0)
+
+	Instrument(&client, provider.Tracer("test"))
+
+	if _, err := client.GetInstance(context.Background(), 123); !linodego.IsNotFound(err) {
+		t.Fatalf("expected not found error, got %v", err)
+	}
+
+	spans := recorder.Ended()
+	if len(spans) != 1 {
+		t.Fatalf("expected a single span for all attempts, got %d", len(spans))
+	}
+
+	span := spans[0]
+
+	if span.Status().Code != codes.Error {
+		t.Errorf("expected span status to be an error, got %v", span.Status())
+	}
+
+	attrs := make(map[string]any)
+	for _, attr := range span.Attributes() {
+		attrs[string(attr.Key)] = attr.Value.AsInterface()
+	}
+
+	if attrs["linodego.retries"] != int64(1) {
+		t.Errorf("expected 1 retry to be recorded, got %v", attrs["linodego.retries"])
+	}
+
+	if attrs["http.response.status_code"] != int64(http.StatusNotFound) {
+		t.Errorf("expected status code 404 to be recorded, got %v", attrs["http.response.status_code"])
+	}
+
+	var retryEvents int
+	for _, event := range span.Events() {
+		if event.Name == "retry" {
+			retryEvents++
+		}
+	}
+
+	if retryEvents != 1 {
+		t.Errorf("expected 1 retry event, got %d", retryEvents)
+	}
+}
@@ -82,7 +82,7 @@ func (resp *LinodeTypesPagedResponse) castResult(r *resty.Request, e string) (in
 	return castedRes.Pages, castedRes.Results, nil
 }
 
-// ListTypes lists linode types. This endpoint is cached by default.
+// ListTypes lists linode types. This endpoint is cached when response caching is enabled.
 func (c *Client) ListTypes(ctx context.Context, opts *ListOptions) ([]LinodeType, error) {
 	response := LinodeTypesPagedResponse{}
 
@@ -105,7 +105,7 @@ func (c *Client) ListTypes(ctx context.Context, opts *ListOptions) ([]LinodeType
 	return response.Data, nil
 }
 
-// GetType gets the type with the provided ID. This endpoint is cached by default.
+// GetType gets the type with the provided ID. This endpoint is cached when response caching is enabled.
 func (c *Client) GetType(ctx context.Context, typeID string) (*LinodeType, error) {
 	e := fmt.Sprintf("linode/types/%s", url.PathEscape(typeID))
 
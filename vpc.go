@@ -95,7 +95,7 @@ func (c *Client) CreateVPC(
 	ctx context.Context,
 	opts VPCCreateOptions,
 ) (*VPC, error) {
-	body, err := json.Marshal(opts)
+	body, err := c.marshalJSON(opts)
 	if err != nil {
 		return nil, err
 	}
@@ -133,7 +133,7 @@ func (c *Client) UpdateVPC(
 	vpcID int,
 	opts VPCUpdateOptions,
 ) (*VPC, error) {
-	body, err := json.Marshal(opts)
+	body, err := c.marshalJSON(opts)
 	if err != nil {
 		return nil, err
 	}
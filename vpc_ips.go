@@ -0,0 +1,68 @@
+package linodego
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// VPCIP represents an IP address allocated in a VPC subnet, along with the
+// configuration interface it is assigned to.
+type VPCIP struct {
+	Address      *string `json:"address"`
+	AddressRange *string `json:"address_range"`
+	Gateway      *string `json:"gateway"`
+	SubnetMask   string  `json:"subnet_mask"`
+	Prefix       int     `json:"prefix"`
+	Region       string  `json:"region"`
+	Active       bool    `json:"active"`
+	NAT1To1      *string `json:"nat_1_1"`
+	VPCID        int     `json:"vpc_id"`
+	SubnetID     int     `json:"subnet_id"`
+	LinodeID     int     `json:"linode_id"`
+	ConfigID     int     `json:"config_id"`
+	InterfaceID  int     `json:"interface_id"`
+}
+
+// VPCIPsPagedResponse represents a paginated VPC IP API response
+type VPCIPsPagedResponse struct {
+	*PageOptions
+	Data []VPCIP `json:"data"`
+}
+
+func (VPCIPsPagedResponse) endpoint(ids ...any) string {
+	id := ids[0].(int)
+	return fmt.Sprintf("vpcs/%d/ips", id)
+}
+
+func (resp *VPCIPsPagedResponse) castResult(r *resty.Request, e string) (int, int, error) {
+	res, err := coupleAPIErrors(r.SetResult(VPCIPsPagedResponse{}).Get(e))
+	if err != nil {
+		return 0, 0, err
+	}
+	castedRes := res.Result().(*VPCIPsPagedResponse)
+	resp.Data = append(resp.Data, castedRes.Data...)
+	return castedRes.Pages, castedRes.Results, nil
+}
+
+// ListVPCIPAddresses lists the IP addresses allocated in all subnets of the VPC
+func (c *Client) ListVPCIPAddresses(ctx context.Context, vpcID int, opts *ListOptions) ([]VPCIP, error) {
+	response := VPCIPsPagedResponse{}
+	err := c.listHelper(ctx, &response, opts, vpcID)
+	if err != nil {
+		return nil, err
+	}
+	return response.Data, nil
+}
+
+// ListVPCSubnetIPs lists the IP addresses allocated in the given subnet of the VPC.
+// The subnet is merged into the Filter of opts.
+func (c *Client) ListVPCSubnetIPs(ctx context.Context, vpcID int, subnetID int, opts *ListOptions) ([]VPCIP, error) {
+	opts, err := withFilterField(opts, "subnet_id", subnetID)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.ListVPCIPAddresses(ctx, vpcID, opts)
+}
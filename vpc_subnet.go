@@ -99,7 +99,7 @@ func (c *Client) CreateVPCSubnet(
 	opts VPCSubnetCreateOptions,
 	vpcID int,
 ) (*VPCSubnet, error) {
-	body, err := json.Marshal(opts)
+	body, err := c.marshalJSON(opts)
 	if err != nil {
 		return nil, err
 	}
@@ -148,7 +148,7 @@ func (c *Client) UpdateVPCSubnet(
 	subnetID int,
 	opts VPCSubnetUpdateOptions,
 ) (*VPCSubnet, error) {
-	body, err := json.Marshal(opts)
+	body, err := c.marshalJSON(opts)
 	if err != nil {
 		return nil, err
 	}
@@ -14,6 +14,26 @@ import (
 
 var englishTitle = cases.Title(language.English)
 
+// instanceStatusEventGracePeriod is how long WaitForInstanceStatusViaEvents waits
+// for a relevant event to appear before falling back to polling the instance status.
+var instanceStatusEventGracePeriod = 30 * time.Second
+
+// instanceStatusEventActions are the event actions that complete with an instance
+// reaching the given status.
+var instanceStatusEventActions = map[InstanceStatus][]EventAction{
+	InstanceRunning: {ActionLinodeBoot, ActionLinodeReboot, ActionLinodeCreate},
+	InstanceOffline: {ActionLinodeShutdown},
+}
+
+// EventPoller waits for new events of an entity. To avoid missing events that complete
+// quickly and matching stale events, create the poller before issuing the request that
+// triggers the event, as it records the latest existing event as a watermark:
+//
+//	p, err := client.NewEventPoller(ctx, instanceID, linodego.EntityLinode, linodego.ActionLinodeBoot)
+//	err = client.BootInstance(ctx, instanceID, 0)
+//	event, err := p.WaitForFinished(ctx, 300)
+//
+// Only events newer than the watermark are considered by WaitForFinished.
 type EventPoller struct {
 	EntityID   any
 	EntityType EntityType
@@ -26,6 +46,9 @@ type EventPoller struct {
 
 	client         Client
 	previousEvents map[int]bool
+
+	// watermark is the ID of the latest event that existed when the poller was seeded
+	watermark int
 }
 
 // WaitForInstanceStatus waits for the Linode instance to reach the desired state
@@ -55,6 +78,314 @@ func (client Client) WaitForInstanceStatus(ctx context.Context, instanceID int,
 	}
 }
 
+// WaitForInstanceStatusViaEvents waits for the Linode instance to reach the desired state
+// before returning, using the events of the instance rather than repeatedly fetching it.
+// The instance is only fetched once the latest boot, reboot or shutdown event has finished,
+// and an error is returned if the event fails. Unlike WaitForInstanceStatus it takes minStart,
+// which should be taken before the action is started: the events of an instance are kept, so
+// only events created since minStart are considered, e.g. not the finished event of an earlier boot.
+// It falls back to WaitForInstanceStatus if the status does not correspond to an event, no relevant
+// event appears within 30 seconds, or the instance is not in the status once the event has finished.
+// It will timeout with an error after timeoutSeconds.
+func (client Client) WaitForInstanceStatusViaEvents(
+	ctx context.Context, instanceID int, status InstanceStatus, minStart time.Time, timeoutSeconds int,
+) (*Instance, error) {
+	actions, ok := instanceStatusEventActions[status]
+	if !ok {
+		return client.WaitForInstanceStatus(ctx, instanceID, status, timeoutSeconds)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(timeoutSeconds)*time.Second)
+	defer cancel()
+
+	actionNodes := make([]FilterNode, len(actions))
+	for i, action := range actions {
+		actionNodes[i] = &Comp{"action", Eq, action}
+	}
+
+	filter := NewFilter().
+		Eq("entity.id", instanceID).
+		Eq("entity.type", EntityLinode).
+		Gte("created", minStart.UTC().Format("2006-01-02T15:04:05")).
+		Or(actionNodes...).
+		SetOrder("created", Descending)
+
+	graceDeadline := time.Now().Add(instanceStatusEventGracePeriod)
+
+	ticker := time.NewTicker(client.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			events, err := client.ListEvents(ctx, NewListOptions(1, filter.String()))
+			if err != nil {
+				return nil, err
+			}
+
+			if len(events) > 0 {
+				event := events[0]
+
+				switch event.Status {
+				case EventFailed:
+					return nil, fmt.Errorf("Error waiting for Instance %d status %s: %s event %d failed", instanceID, status, event.Action, event.ID)
+				case EventFinished:
+					instance, err := client.GetInstance(ctx, instanceID)
+					if err != nil {
+						return instance, err
+					}
+
+					if instance.Status == status {
+						return instance, nil
+					}
+
+					// The status may have changed since, e.g. by another action
+					return client.waitForInstanceStatusUntilDeadline(ctx, instanceID, status)
+				}
+
+				continue
+			}
+
+			// The event may be missing, e.g. if the instance was already in the status
+			if time.Now().After(graceDeadline) {
+				return client.waitForInstanceStatusUntilDeadline(ctx, instanceID, status)
+			}
+		case <-ctx.Done():
+			return nil, fmt.Errorf("Error waiting for Instance %d status %s: %w", instanceID, status, ctx.Err())
+		}
+	}
+}
+
+// waitForInstanceStatusUntilDeadline calls WaitForInstanceStatus with the time remaining until the deadline of ctx
+func (client Client) waitForInstanceStatusUntilDeadline(ctx context.Context, instanceID int, status InstanceStatus) (*Instance, error) {
+	deadline, _ := ctx.Deadline()
+	return client.WaitForInstanceStatus(ctx, instanceID, status, int(time.Until(deadline).Seconds())+1)
+}
+
+// instanceMigrationEventActions are the event actions of migrations within and between regions
+var instanceMigrationEventActions = []EventAction{ActionLinodeMigrate, ActionLinodeMigrateDatacenter}
+
+// WaitForInstanceMigration waits for the latest migration event of the Linode instance created since
+// minStart, which should be taken before calling MigrateInstance, to finish and returns the migrated
+// instance. Scheduled migrations are waited on until they have run. It will timeout with an error
+// after timeoutSeconds.
+func (client Client) WaitForInstanceMigration(ctx context.Context, instanceID int, minStart time.Time, timeoutSeconds int) (*Instance, error) {
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(timeoutSeconds)*time.Second)
+	defer cancel()
+
+	actionNodes := make([]FilterNode, len(instanceMigrationEventActions))
+	for i, action := range instanceMigrationEventActions {
+		actionNodes[i] = &Comp{"action", Eq, action}
+	}
+
+	filter := NewFilter().
+		Eq("entity.id", instanceID).
+		Eq("entity.type", EntityLinode).
+		Gte("created", minStart.UTC().Format("2006-01-02T15:04:05")).
+		Or(actionNodes...).
+		SetOrder("created", Descending)
+
+	ticker := time.NewTicker(client.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			events, err := client.ListEvents(ctx, NewListOptions(1, filter.String()))
+			if err != nil {
+				if ctx.Err() != nil {
+					// The timeout may have been reached during a request
+					err = ctx.Err()
+				}
+
+				return nil, fmt.Errorf("Error waiting for Instance %d migration: %w", instanceID, err)
+			}
+
+			if len(events) == 0 {
+				continue
+			}
+
+			switch event := events[0]; event.Status {
+			case EventFinished:
+				return client.GetInstance(ctx, instanceID)
+			case EventFailed:
+				return nil, fmt.Errorf("Error waiting for Instance %d migration: %s event %d failed", instanceID, event.Action, event.ID)
+			}
+		case <-ctx.Done():
+			return nil, fmt.Errorf("Error waiting for Instance %d migration: %w", instanceID, ctx.Err())
+		}
+	}
+}
+
+// InstanceCloneError is returned by WaitForInstanceClone when the clone did not complete.
+// InstanceID is the ID of the partially created Instance, which may need to be deleted.
+type InstanceCloneError struct {
+	InstanceID int
+	Err        error
+}
+
+func (e *InstanceCloneError) Error() string {
+	return fmt.Sprintf("Error waiting for clone Instance %d: %s", e.InstanceID, e.Err)
+}
+
+func (e *InstanceCloneError) Unwrap() error {
+	return e.Err
+}
+
+// WaitForInstanceClone waits for the linode_clone event of sourceID into the Instance cloneID,
+// as returned by CloneInstance, to finish and returns the cloned Instance.
+// If progress is not nil, it is called with the percent complete of the clone whenever it changes.
+// If the clone fails or the timeout is reached, an *InstanceCloneError holding cloneID is returned.
+func (client Client) WaitForInstanceClone(
+	ctx context.Context, sourceID, cloneID int, timeoutSeconds int, progress func(percentComplete int),
+) (*Instance, error) {
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(timeoutSeconds)*time.Second)
+	defer cancel()
+
+	// The clone event already exists, so the poller is not seeded with the current events
+	poller, err := client.NewEventPollerWithoutEntity(EntityLinode, ActionLinodeClone)
+	if err != nil {
+		return nil, &InstanceCloneError{InstanceID: cloneID, Err: err}
+	}
+
+	poller.EntityID = sourceID
+	poller.SecondaryEntityID = cloneID
+
+	event, err := poller.WaitForLatestUnknownEvent(ctx)
+	if err != nil {
+		if ctx.Err() != nil {
+			// The timeout may have been reached during a request
+			err = ctx.Err()
+		}
+
+		return nil, &InstanceCloneError{InstanceID: cloneID, Err: err}
+	}
+
+	ticker := time.NewTicker(client.pollInterval)
+	defer ticker.Stop()
+
+	lastPercent := -1
+
+	for {
+		if progress != nil && event.PercentComplete != lastPercent {
+			lastPercent = event.PercentComplete
+			progress(lastPercent)
+		}
+
+		switch event.Status {
+		case EventFinished:
+			return client.GetInstance(ctx, cloneID)
+		case EventFailed:
+			return nil, &InstanceCloneError{InstanceID: cloneID, Err: fmt.Errorf("event %d has failed", event.ID)}
+		}
+
+		select {
+		case <-ticker.C:
+			if event, err = client.GetEvent(ctx, event.ID); err != nil {
+				if ctx.Err() != nil {
+					err = ctx.Err()
+				}
+
+				return nil, &InstanceCloneError{InstanceID: cloneID, Err: fmt.Errorf("failed to get event: %w", err)}
+			}
+		case <-ctx.Done():
+			return nil, &InstanceCloneError{InstanceID: cloneID, Err: ctx.Err()}
+		}
+	}
+}
+
+// WaitForCondition calls getter until pred returns true for its result or ctx is done,
+// waiting interval between calls. The first call is made immediately.
+// If interval is not positive, the default client poll delay is used; pass
+// Client.GetPollDelay() to use the poll delay of a specific client.
+// An error returned by getter is returned immediately along with its result.
+// When ctx is done, the last fetched value is returned with the context error.
+func WaitForCondition[T any](
+	ctx context.Context,
+	getter func(context.Context) (T, error),
+	pred func(T) bool,
+	interval time.Duration,
+) (T, error) {
+	if interval <= 0 {
+		interval = APISecondsPerPoll * time.Second
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		result, err := getter(ctx)
+		if err != nil {
+			return result, err
+		}
+
+		if pred(result) {
+			return result, nil
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return result, fmt.Errorf("Error waiting for condition: %w", ctx.Err())
+		}
+	}
+}
+
+// WaitForResourceDeletion calls getter until it returns an error matching IsNotFound,
+// indicating that the resource has been deleted. Any other error is returned immediately.
+// It will timeout with an error after timeoutSeconds.
+func (client Client) WaitForResourceDeletion(ctx context.Context, getter func(context.Context) error, timeoutSeconds int) error {
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(timeoutSeconds)*time.Second)
+	defer cancel()
+
+	ticker := time.NewTicker(client.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			err := getter(ctx)
+			if IsNotFound(err) {
+				return nil
+			}
+
+			if err != nil {
+				return err
+			}
+		case <-ctx.Done():
+			return fmt.Errorf("failed to wait for resource deletion: %w", ctx.Err())
+		}
+	}
+}
+
+// WaitForInstanceDeletion waits for the Instance to no longer exist after it was deleted.
+// It will timeout with an error after timeoutSeconds.
+func (client Client) WaitForInstanceDeletion(ctx context.Context, instanceID int, timeoutSeconds int) error {
+	err := client.WaitForResourceDeletion(ctx, func(ctx context.Context) error {
+		_, err := client.GetInstance(ctx, instanceID)
+		return err
+	}, timeoutSeconds)
+	if err != nil {
+		return fmt.Errorf("failed to wait for Instance %d deletion: %w", instanceID, err)
+	}
+
+	return nil
+}
+
+// WaitForVolumeDeletion waits for the Volume to no longer exist after it was deleted.
+// It will timeout with an error after timeoutSeconds.
+func (client Client) WaitForVolumeDeletion(ctx context.Context, volumeID int, timeoutSeconds int) error {
+	err := client.WaitForResourceDeletion(ctx, func(ctx context.Context) error {
+		_, err := client.GetVolume(ctx, volumeID)
+		return err
+	}, timeoutSeconds)
+	if err != nil {
+		return fmt.Errorf("failed to wait for Volume %d deletion: %w", volumeID, err)
+	}
+
+	return nil
+}
+
 // WaitForInstanceDiskStatus waits for the Linode instance disk to reach the desired state
 // before returning. It will timeout with an error after timeoutSeconds.
 func (client Client) WaitForInstanceDiskStatus(ctx context.Context, instanceID int, diskID int, status DiskStatus, timeoutSeconds int) (*InstanceDisk, error) {
@@ -145,6 +476,64 @@ func (client Client) WaitForSnapshotStatus(ctx context.Context, instanceID int,
 	}
 }
 
+// WaitForInstanceIPAddress waits for the Linode instance to be assigned an IPv4 address, which may
+// lag behind its creation, and returns the IP addresses of the instance. It will timeout with an
+// error after timeoutSeconds.
+func (client Client) WaitForInstanceIPAddress(ctx context.Context, instanceID int, timeoutSeconds int) (*InstanceIPAddressResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(timeoutSeconds)*time.Second)
+	defer cancel()
+
+	ticker := time.NewTicker(client.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			instance, err := client.GetInstance(ctx, instanceID)
+			if err != nil {
+				return nil, err
+			}
+
+			if len(instance.IPv4) > 0 {
+				return client.GetInstanceIPAddresses(ctx, instanceID)
+			}
+		case <-ctx.Done():
+			return nil, fmt.Errorf("Error waiting for Instance %d IPv4 address: %w", instanceID, ctx.Err())
+		}
+	}
+}
+
+// WaitForInstanceVPCIPAddress waits for a VPC interface of the Linode instance to be assigned
+// an IPv4 address in its subnet and returns the IP addresses of the instance, including the VPC
+// addresses in IPv4.VPC. It will timeout with an error after timeoutSeconds.
+func (client Client) WaitForInstanceVPCIPAddress(ctx context.Context, instanceID int, timeoutSeconds int) (*InstanceIPAddressResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(timeoutSeconds)*time.Second)
+	defer cancel()
+
+	ticker := time.NewTicker(client.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			addresses, err := client.GetInstanceIPAddresses(ctx, instanceID)
+			if err != nil {
+				return nil, err
+			}
+
+			if addresses.IPv4 != nil {
+				for _, ip := range addresses.IPv4.VPC {
+					if ip.Address != nil && *ip.Address != "" {
+						return addresses, nil
+					}
+				}
+			}
+		case <-ctx.Done():
+			return nil, fmt.Errorf("Error waiting for Instance %d VPC IPv4 address: %w", instanceID, ctx.Err())
+		}
+	}
+}
+
 // WaitForVolumeLinodeID waits for the Volume to match the desired LinodeID
 // before returning. An active Instance will not immediately attach or detach a volume, so
 // the LinodeID must be polled to determine volume readiness from the API.
@@ -156,6 +545,8 @@ func (client Client) WaitForVolumeLinodeID(ctx context.Context, volumeID int, li
 	ticker := time.NewTicker(client.pollInterval)
 	defer ticker.Stop()
 
+	var lastLinodeID *int
+
 	for {
 		select {
 		case <-ticker.C:
@@ -164,6 +555,8 @@ func (client Client) WaitForVolumeLinodeID(ctx context.Context, volumeID int, li
 				return volume, err
 			}
 
+			lastLinodeID = volume.LinodeID
+
 			switch {
 			case linodeID == nil && volume.LinodeID == nil:
 				return volume, nil
@@ -173,11 +566,23 @@ func (client Client) WaitForVolumeLinodeID(ctx context.Context, volumeID int, li
 				return volume, nil
 			}
 		case <-ctx.Done():
-			return nil, fmt.Errorf("Error waiting for Volume %d to have Instance %v: %w", volumeID, linodeID, ctx.Err())
+			return nil, fmt.Errorf(
+				"Error waiting for Volume %d to be %s (last observed: %s): %w",
+				volumeID, describeVolumeAttachment(linodeID), describeVolumeAttachment(lastLinodeID), ctx.Err(),
+			)
 		}
 	}
 }
 
+// describeVolumeAttachment describes the attachment of a Volume to the Instance with the given ID.
+func describeVolumeAttachment(linodeID *int) string {
+	if linodeID == nil {
+		return "detached"
+	}
+
+	return fmt.Sprintf("attached to Instance %d", *linodeID)
+}
+
 // WaitForLKEClusterStatus waits for the LKECluster to reach the desired state
 // before returning. It will timeout with an error after timeoutSeconds.
 func (client Client) WaitForLKEClusterStatus(ctx context.Context, clusterID int, status LKEClusterStatus, timeoutSeconds int) (*LKECluster, error) {
@@ -205,6 +610,61 @@ func (client Client) WaitForLKEClusterStatus(ctx context.Context, clusterID int,
 	}
 }
 
+// WaitForLKENodePoolReady waits for the nodes of the LKE Node Pool to match its Count and be ready.
+// If the timeout is reached, the last observed state of the Node Pool is returned along with the error.
+func (client Client) WaitForLKENodePoolReady(ctx context.Context, clusterID, poolID int, timeoutSeconds int) (*LKENodePool, error) {
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(timeoutSeconds)*time.Second)
+	defer cancel()
+
+	ticker := time.NewTicker(client.pollInterval)
+	defer ticker.Stop()
+
+	var lastPool *LKENodePool
+
+	for {
+		select {
+		case <-ticker.C:
+			pool, err := client.GetLKENodePool(ctx, clusterID, poolID)
+			if err != nil {
+				if ctx.Err() != nil && lastPool != nil {
+					// The timeout was reached during the request
+					continue
+				}
+
+				return pool, err
+			}
+
+			lastPool = pool
+
+			if countReadyLKENodes(pool) == pool.Count && len(pool.Linodes) == pool.Count {
+				return pool, nil
+			}
+		case <-ctx.Done():
+			if lastPool == nil {
+				return nil, fmt.Errorf("Error waiting for Node Pool %d of Cluster %d to be ready: %w", poolID, clusterID, ctx.Err())
+			}
+
+			return lastPool, fmt.Errorf(
+				"Error waiting for Node Pool %d of Cluster %d to be ready (%d of %d nodes ready): %w",
+				poolID, clusterID, countReadyLKENodes(lastPool), lastPool.Count, ctx.Err(),
+			)
+		}
+	}
+}
+
+// countReadyLKENodes returns the number of ready nodes in the LKE Node Pool.
+func countReadyLKENodes(pool *LKENodePool) int {
+	ready := 0
+
+	for _, node := range pool.Linodes {
+		if node.Status == LKELinodeReady {
+			ready++
+		}
+	}
+
+	return ready
+}
+
 // LKEClusterPollOptions configures polls against LKE Clusters.
 type LKEClusterPollOptions struct {
 	// Retry will cause the Poll to ignore interimittent errors
@@ -415,6 +875,50 @@ func (client Client) WaitForEventFinished(
 	}
 }
 
+// WaitForEventProgress waits for the Event to finish, calling onProgress with the
+// PercentComplete of the Event whenever it changes. onProgress is always called with
+// 100 once the Event finishes, even for events that never report any progress.
+// If the Event fails both the failed Event and an error are returned.
+// It will timeout with an error after timeoutSeconds.
+func (client Client) WaitForEventProgress(ctx context.Context, eventID int, onProgress func(percent int), timeoutSeconds int) (*Event, error) {
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(timeoutSeconds)*time.Second)
+	defer cancel()
+
+	ticker := time.NewTicker(client.pollInterval)
+	defer ticker.Stop()
+
+	lastPercent := -1
+	report := func(percent int) {
+		if onProgress != nil && percent != lastPercent {
+			onProgress(percent)
+		}
+
+		lastPercent = percent
+	}
+
+	for {
+		select {
+		case <-ticker.C:
+			event, err := client.GetEvent(ctx, eventID)
+			if err != nil {
+				return nil, err
+			}
+
+			switch event.Status {
+			case EventFailed:
+				return event, fmt.Errorf("event %d failed", eventID)
+			case EventFinished, EventNotification:
+				report(100)
+				return event, nil
+			}
+
+			report(event.PercentComplete)
+		case <-ctx.Done():
+			return nil, fmt.Errorf("failed to wait for Event %d to finish: %w", eventID, ctx.Err())
+		}
+	}
+}
+
 // WaitForImageStatus waits for the Image to reach the desired state
 // before returning. It will timeout with an error after timeoutSeconds.
 func (client Client) WaitForImageStatus(ctx context.Context, imageID string, status ImageStatus, timeoutSeconds int) (*Image, error) {
@@ -442,6 +946,58 @@ func (client Client) WaitForImageStatus(ctx context.Context, imageID string, sta
 	}
 }
 
+// WaitForImageRegionsAvailable waits for the Image to be available in all of the given regions, or in all
+// of its regions if none are given, and returns the Image. It fails if the replication to a region times out.
+// It will timeout with an error after timeoutSeconds.
+func (client Client) WaitForImageRegionsAvailable(ctx context.Context, imageID string, regions []string, timeoutSeconds int) (*Image, error) {
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(timeoutSeconds)*time.Second)
+	defer cancel()
+
+	ticker := time.NewTicker(client.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			image, err := client.GetImage(ctx, imageID)
+			if err != nil {
+				return image, err
+			}
+
+			statuses := make(map[string]ImageRegionStatus, len(image.Regions))
+			for _, region := range image.Regions {
+				statuses[region.Region] = region.Status
+			}
+
+			waitFor := regions
+			if len(waitFor) == 0 {
+				waitFor = make([]string, 0, len(image.Regions))
+				for _, region := range image.Regions {
+					waitFor = append(waitFor, region.Region)
+				}
+			}
+
+			complete := true
+
+			for _, region := range waitFor {
+				switch statuses[region] {
+				case ImageRegionStatusAvailable:
+				case ImageRegionStatusTimedOut:
+					return nil, fmt.Errorf("failed to wait for Image %s in region %s: replication timed out", imageID, region)
+				default:
+					complete = false
+				}
+			}
+
+			if complete {
+				return image, nil
+			}
+		case <-ctx.Done():
+			return nil, fmt.Errorf("failed to wait for Image %s regions available: %w", imageID, ctx.Err())
+		}
+	}
+}
+
 // WaitForMySQLDatabaseBackup waits for the backup with the given label to be available.
 func (client Client) WaitForMySQLDatabaseBackup(ctx context.Context, dbID int, label string, timeoutSeconds int) (*MySQLDatabaseBackup, error) {
 	ctx, cancel := context.WithTimeout(ctx, time.Duration(timeoutSeconds)*time.Second)
@@ -527,14 +1083,15 @@ func (client Client) WaitForDatabaseStatus(
 	ticker := time.NewTicker(client.pollInterval)
 	defer ticker.Stop()
 
+	if err := validateDatabase(dbEngine, dbID); err != nil {
+		return err
+	}
+
+	statusHandler := databaseStatusHandlers[dbEngine]
+
 	for {
 		select {
 		case <-ticker.C:
-			statusHandler, ok := databaseStatusHandlers[dbEngine]
-			if !ok {
-				return fmt.Errorf("invalid db engine: %s", dbEngine)
-			}
-
 			currentStatus, err := statusHandler(ctx, client, dbID)
 			if err != nil {
 				return fmt.Errorf("failed to get db status: %w", err)
@@ -549,6 +1106,46 @@ func (client Client) WaitForDatabaseStatus(
 	}
 }
 
+// databaseRestoreGracePeriod is how long waitForDatabaseRestored waits for a restore to start
+// before accepting an active database as restored.
+const databaseRestoreGracePeriod = 30 * time.Second
+
+// waitForDatabaseRestored waits for a restore of the database to start and complete.
+// A database that is still active when the restore was requested is not considered restored
+// until it has left the active status or the grace period has passed.
+func (client Client) waitForDatabaseRestored(ctx context.Context, dbID int, dbEngine DatabaseEngineType, timeoutSeconds int) error {
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(timeoutSeconds)*time.Second)
+	defer cancel()
+
+	ticker := time.NewTicker(client.pollInterval)
+	defer ticker.Stop()
+
+	statusHandler := databaseStatusHandlers[dbEngine]
+	graceDeadline := time.Now().Add(databaseRestoreGracePeriod)
+	restoreStarted := false
+
+	for {
+		select {
+		case <-ticker.C:
+			currentStatus, err := statusHandler(ctx, client, dbID)
+			if err != nil {
+				return fmt.Errorf("failed to get db status: %w", err)
+			}
+
+			switch {
+			case currentStatus == DatabaseStatusFailed:
+				return fmt.Errorf("failed to restore database %d: database status is %s", dbID, currentStatus)
+			case currentStatus != DatabaseStatusActive:
+				restoreStarted = true
+			case restoreStarted || time.Now().After(graceDeadline):
+				return nil
+			}
+		case <-ctx.Done():
+			return fmt.Errorf("failed to wait for database %d to be restored: %w", dbID, ctx.Err())
+		}
+	}
+}
+
 // NewEventPoller initializes a new Linode event poller. This should be run before the event is triggered as it stores
 // the previous state of the entity's events.
 func (client Client) NewEventPoller(
@@ -605,7 +1202,7 @@ func (client Client) NewEventPollerWithoutEntity(entityType EntityType, action E
 }
 
 // PreTask stores all current events for the given entity to prevent them from being
-// processed on subsequent runs.
+// processed on subsequent runs, and records the ID of the latest one as the watermark.
 func (p *EventPoller) PreTask(ctx context.Context) error {
 	f := Filter{
 		OrderBy: "created",
@@ -631,6 +1228,10 @@ func (p *EventPoller) PreTask(ctx context.Context) error {
 	eventIDs := make(map[int]bool, len(events))
 	for _, event := range events {
 		eventIDs[event.ID] = true
+
+		if event.ID > p.watermark {
+			p.watermark = event.ID
+		}
 	}
 
 	p.previousEvents = eventIDs
@@ -650,6 +1251,10 @@ func (p *EventPoller) WaitForLatestUnknownEvent(ctx context.Context) (*Event, er
 	f.AddField(Eq, "entity.id", p.EntityID)
 	f.AddField(Eq, "action", p.Action)
 
+	if p.watermark > 0 {
+		f.AddField(Gte, "id", p.watermark)
+	}
+
 	fBytes, err := f.MarshalJSON()
 	if err != nil {
 		return nil, err
@@ -673,6 +1278,11 @@ func (p *EventPoller) WaitForLatestUnknownEvent(ctx context.Context) (*Event, er
 					continue
 				}
 
+				if event.ID <= p.watermark {
+					// This event existed before the poller was seeded
+					continue
+				}
+
 				if _, ok := p.previousEvents[event.ID]; !ok {
 					// Store this event so it is no longer picked up
 					// on subsequent jobs
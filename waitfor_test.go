@@ -0,0 +1,651 @@
+package linodego
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestWaitForInstanceStatusViaEvents(t *testing.T) {
+	var eventRequests, instanceRequests int
+
+	ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/v4/account/events":
+			eventRequests++
+
+			var filter map[string]any
+			if err := json.Unmarshal([]byte(r.Header.Get("X-Filter")), &filter); err != nil || filter["entity.id"] != float64(123) ||
+				!reflect.DeepEqual(filter["created"], map[string]any{"+gte": "2024-01-01T00:00:00"}) {
+				t.Errorf("unexpected event filter: %s", r.Header.Get("X-Filter"))
+			}
+
+			status := EventStarted
+			if eventRequests > 2 {
+				status = EventFinished
+			}
+
+			json.NewEncoder(rw).Encode(map[string]any{
+				"data":    []map[string]any{{"id": 1, "action": ActionLinodeBoot, "status": status}},
+				"page":    1,
+				"pages":   1,
+				"results": 1,
+			})
+		case "/v4/linode/instances/123":
+			instanceRequests++
+
+			rw.Write([]byte(`{"id": 123, "status": "running"}`))
+		default:
+			rw.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	client := NewClient(nil)
+	client.SetBaseURL(ts.URL)
+	client.SetPollDelay(time.Millisecond)
+
+	minStart := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	instance, err := client.WaitForInstanceStatusViaEvents(context.Background(), 123, InstanceRunning, minStart, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if instance.Status != InstanceRunning {
+		t.Errorf("expected instance to be running, got %s", instance.Status)
+	}
+
+	if eventRequests != 3 || instanceRequests != 1 {
+		t.Errorf("expected 3 event requests and 1 instance request, got %d and %d", eventRequests, instanceRequests)
+	}
+}
+
+func TestWaitForInstanceStatusViaEvents_gracePeriod(t *testing.T) {
+	defer func(gracePeriod time.Duration) { instanceStatusEventGracePeriod = gracePeriod }(instanceStatusEventGracePeriod)
+	instanceStatusEventGracePeriod = 10 * time.Millisecond
+
+	var eventRequests, instanceRequests int
+
+	ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/v4/account/events":
+			eventRequests++
+
+			switch {
+			case strings.Contains(r.Header.Get("X-Filter"), "2099"):
+				// No event was created for the instance
+				rw.Write([]byte(`{"data": [], "page": 1, "pages": 1, "results": 0}`))
+			case eventRequests < 30:
+				rw.Write([]byte(`{"data": [{"id": 1, "action": "linode_boot", "status": "started"}], "page": 1, "pages": 1, "results": 1}`))
+			default:
+				rw.Write([]byte(`{"data": [{"id": 1, "action": "linode_boot", "status": "finished"}], "page": 1, "pages": 1, "results": 1}`))
+			}
+		case "/v4/linode/instances/123":
+			instanceRequests++
+
+			rw.Write([]byte(`{"id": 123, "status": "running"}`))
+		default:
+			rw.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	client := NewClient(nil)
+	client.SetBaseURL(ts.URL)
+	client.SetPollDelay(time.Millisecond)
+
+	// An event that is still in progress is waited on past the grace period
+	instance, err := client.WaitForInstanceStatusViaEvents(context.Background(), 123, InstanceRunning, time.Now(), 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if instance.Status != InstanceRunning || eventRequests != 30 || instanceRequests != 1 {
+		t.Errorf("expected the instance to be fetched once the event finished, got %d event and %d instance requests",
+			eventRequests, instanceRequests)
+	}
+
+	// The instance is polled once no event has appeared within the grace period
+	instanceRequests = 0
+
+	minStart := time.Date(2099, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	instance, err = client.WaitForInstanceStatusViaEvents(context.Background(), 123, InstanceRunning, minStart, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if instance.Status != InstanceRunning || instanceRequests != 1 {
+		t.Errorf("expected the instance status to be polled, got %d instance requests", instanceRequests)
+	}
+}
+
+func TestWaitForCondition(t *testing.T) {
+	calls := 0
+	getter := func(context.Context) (int, error) {
+		calls++
+		return calls, nil
+	}
+
+	result, err := WaitForCondition(context.Background(), getter, func(v int) bool { return v == 3 }, time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if result != 3 {
+		t.Errorf("expected result 3, got %d", result)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	result, err = WaitForCondition(ctx, getter, func(int) bool { return false }, time.Millisecond)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected deadline exceeded error, got %v", err)
+	}
+
+	if result != calls {
+		t.Errorf("expected the last fetched value %d to be returned, got %d", calls, result)
+	}
+
+	getterErr := errors.New("getter failed")
+	if _, err := WaitForCondition(context.Background(), func(context.Context) (int, error) {
+		return 0, getterErr
+	}, func(int) bool { return true }, 0); !errors.Is(err, getterErr) {
+		t.Errorf("expected getter error to be returned, got %v", err)
+	}
+
+	// A non-positive interval falls back to the default poll delay
+	ctx, cancel = context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	calls = 0
+	if _, err := WaitForCondition(ctx, getter, func(int) bool { return false }, -time.Second); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected deadline exceeded error, got %v", err)
+	}
+
+	if calls != 1 {
+		t.Errorf("expected a single call within the default poll delay, got %d", calls)
+	}
+}
+
+func TestWaitForVolumeLinodeIDTimeout(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(`{"id": 456, "linode_id": 123}`))
+	}))
+	defer ts.Close()
+
+	client := NewClient(nil)
+	client.SetBaseURL(ts.URL)
+	client.SetPollDelay(15 * time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 40*time.Millisecond)
+	defer cancel()
+
+	_, err := client.WaitForVolumeLinodeID(ctx, 456, nil, 5)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected deadline exceeded error, got %v", err)
+	}
+
+	expected := "Error waiting for Volume 456 to be detached (last observed: attached to Instance 123): context deadline exceeded"
+	if err.Error() != expected {
+		t.Errorf("expected error %q, got %q", expected, err.Error())
+	}
+}
+
+func TestWaitForLKENodePoolReady(t *testing.T) {
+	requests := 0
+
+	ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Set("Content-Type", "application/json")
+
+		if r.URL.Path != "/v4/lke/clusters/123/pools/456" {
+			rw.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		requests++
+
+		// The pool scales from 1 to 2 nodes, and the new node becomes ready on the third request
+		nodes := `[{"id": "a", "status": "ready"}]`
+		switch {
+		case requests == 2:
+			nodes = `[{"id": "a", "status": "ready"}, {"id": "b", "status": "not_ready"}]`
+		case requests > 2:
+			nodes = `[{"id": "a", "status": "ready"}, {"id": "b", "status": "ready"}]`
+		}
+
+		rw.Write([]byte(`{"id": 456, "count": 2, "nodes": ` + nodes + `}`))
+	}))
+	defer ts.Close()
+
+	client := NewClient(nil)
+	client.SetBaseURL(ts.URL)
+	client.SetPollDelay(time.Millisecond)
+
+	pool, err := client.WaitForLKENodePoolReady(context.Background(), 123, 456, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if requests != 3 || len(pool.Linodes) != 2 {
+		t.Errorf("expected the pool to be ready after 3 requests, got %d requests and %d nodes", requests, len(pool.Linodes))
+	}
+}
+
+func TestWaitForLKENodePoolReadyTimeout(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(`{"id": 456, "count": 3, "nodes": [{"id": "a", "status": "ready"}, {"id": "b", "status": "not_ready"}]}`))
+	}))
+	defer ts.Close()
+
+	client := NewClient(nil)
+	client.SetBaseURL(ts.URL)
+	client.SetPollDelay(15 * time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 40*time.Millisecond)
+	defer cancel()
+
+	pool, err := client.WaitForLKENodePoolReady(ctx, 123, 456, 5)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected deadline exceeded error, got %v", err)
+	}
+
+	if pool == nil || len(pool.Linodes) != 2 {
+		t.Fatalf("expected the last pool state to be returned, got %v", pool)
+	}
+
+	expected := "Error waiting for Node Pool 456 of Cluster 123 to be ready (1 of 3 nodes ready): context deadline exceeded"
+	if err.Error() != expected {
+		t.Errorf("expected error %q, got %q", expected, err.Error())
+	}
+}
+
+func TestRestoreDatabaseFromBackup(t *testing.T) {
+	restored := false
+	statusRequests := 0
+
+	ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/v4/databases/postgresql/instances/123/backups/456/restore":
+			restored = true
+			rw.Write([]byte(`{}`))
+		case "/v4/databases/postgresql/instances/123":
+			statusRequests++
+
+			// The database is briefly still active after the restore was requested
+			status := DatabaseStatusRestoring
+			if statusRequests == 1 || statusRequests > 3 {
+				status = DatabaseStatusActive
+			}
+
+			json.NewEncoder(rw).Encode(map[string]any{"id": 123, "status": status})
+		default:
+			rw.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	client := NewClient(nil)
+	client.SetBaseURL(ts.URL)
+	client.SetPollDelay(time.Millisecond)
+
+	if err := client.RestoreDatabaseFromBackup(context.Background(), 123, DatabaseEngineTypePostgres, 456, 5); err != nil {
+		t.Fatal(err)
+	}
+
+	if !restored || statusRequests != 4 {
+		t.Errorf("expected the restore to be waited for, got %d status requests", statusRequests)
+	}
+
+	var invalidErr *InvalidDatabaseError
+
+	err := client.RestoreDatabaseFromBackup(context.Background(), 123, "redis", 456, 5)
+	if !errors.As(err, &invalidErr) || err.Error() != "invalid db engine: redis" {
+		t.Errorf("expected an invalid engine error, got %v", err)
+	}
+
+	err = client.WaitForDatabaseStatus(context.Background(), 0, DatabaseEngineTypeMySQL, DatabaseStatusActive, 5)
+	if !errors.As(err, &invalidErr) || err.Error() != "invalid mysql database ID: 0" {
+		t.Errorf("expected an invalid ID error, got %v", err)
+	}
+}
+
+func TestWaitForInstanceClone(t *testing.T) {
+	eventRequests := 0
+
+	ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/v4/account/events":
+			rw.Write([]byte(`{"data": [
+				{"id": 8, "action": "linode_clone", "status": "started", "percent_complete": 10,
+					"entity": {"id": 123, "type": "linode"}, "secondary_entity": {"id": 999, "type": "linode"}},
+				{"id": 7, "action": "linode_clone", "status": "started", "percent_complete": 10,
+					"entity": {"id": 123, "type": "linode"}, "secondary_entity": {"id": 456, "type": "linode"}}
+			], "page": 1, "pages": 1, "results": 2}`))
+		case "/v4/account/events/7":
+			eventRequests++
+
+			status, percent := "started", 50
+			if eventRequests > 1 {
+				status, percent = "finished", 100
+			}
+
+			json.NewEncoder(rw).Encode(map[string]any{"id": 7, "status": status, "percent_complete": percent})
+		case "/v4/linode/instances/456":
+			rw.Write([]byte(`{"id": 456, "status": "offline"}`))
+		default:
+			rw.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	client := NewClient(nil)
+	client.SetBaseURL(ts.URL)
+	client.SetPollDelay(time.Millisecond)
+
+	var progress []int
+
+	instance, err := client.WaitForInstanceClone(context.Background(), 123, 456, 5, func(percent int) {
+		progress = append(progress, percent)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if instance.ID != 456 {
+		t.Errorf("expected the cloned instance, got %d", instance.ID)
+	}
+
+	if !reflect.DeepEqual(progress, []int{10, 50, 100}) {
+		t.Errorf("unexpected progress %v", progress)
+	}
+
+	// The clone of another instance never finishes
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	_, err = client.WaitForInstanceClone(ctx, 123, 789, 5, nil)
+
+	var cloneErr *InstanceCloneError
+	if !errors.As(err, &cloneErr) || cloneErr.InstanceID != 789 || !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected a clone error for instance 789, got %v", err)
+	}
+}
+
+func TestWaitForInstanceMigration(t *testing.T) {
+	eventRequests := 0
+
+	ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/v4/account/events":
+			eventRequests++
+
+			var filter map[string]any
+			if err := json.Unmarshal([]byte(r.Header.Get("X-Filter")), &filter); err != nil ||
+				!reflect.DeepEqual(filter["created"], map[string]any{"+gte": "2024-01-01T00:00:00"}) {
+				t.Errorf("expected events since the migration to be listed, got filter %s", r.Header.Get("X-Filter"))
+			}
+
+			status := "scheduled"
+			if eventRequests > 2 {
+				status = "finished"
+			}
+
+			fmt.Fprintf(rw, `{"data": [{"id": 7, "action": "linode_migrate_datacenter", "status": %q,
+				"entity": {"id": 123, "type": "linode"}}], "page": 1, "pages": 1, "results": 1}`, status)
+		case "/v4/linode/instances/123":
+			rw.Write([]byte(`{"id": 123, "region": "us-west", "status": "running"}`))
+		default:
+			rw.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	client := NewClient(nil)
+	client.SetBaseURL(ts.URL)
+	client.SetPollDelay(time.Millisecond)
+
+	instance, err := client.WaitForInstanceMigration(context.Background(), 123, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if instance.Region != "us-west" || eventRequests != 3 {
+		t.Errorf("expected the migrated instance after 3 event requests, got %v after %d", instance, eventRequests)
+	}
+}
+
+func TestWaitForInstanceIPAddress(t *testing.T) {
+	ipRequests := 0
+
+	ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/v4/linode/instances/123":
+			rw.Write([]byte(`{"id": 123, "ipv4": ["192.0.2.1"]}`))
+		case "/v4/linode/instances/123/ips":
+			ipRequests++
+
+			vpcAddress := "null"
+			if ipRequests > 2 {
+				vpcAddress = `"10.0.0.2"`
+			}
+
+			fmt.Fprintf(rw, `{"ipv4": {"public": [{"address": "192.0.2.1"}],
+				"vpc": [{"address": %s, "vpc_id": 1, "subnet_id": 2}]}}`, vpcAddress)
+		default:
+			rw.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	client := NewClient(nil)
+	client.SetBaseURL(ts.URL)
+	client.SetPollDelay(time.Millisecond)
+
+	addresses, err := client.WaitForInstanceIPAddress(context.Background(), 123, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(addresses.IPv4.Public) != 1 || addresses.IPv4.Public[0].Address != "192.0.2.1" {
+		t.Errorf("unexpected addresses %v", addresses.IPv4)
+	}
+
+	addresses, err = client.WaitForInstanceVPCIPAddress(context.Background(), 123, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if ipRequests != 3 || len(addresses.IPv4.VPC) != 1 || *addresses.IPv4.VPC[0].Address != "10.0.0.2" {
+		t.Errorf("expected the VPC address after 3 requests, got %v after %d", addresses.IPv4.VPC, ipRequests)
+	}
+}
+
+func TestEventPollerWatermark(t *testing.T) {
+	listRequests := 0
+
+	ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/v4/account/events":
+			listRequests++
+
+			var filter map[string]any
+			json.Unmarshal([]byte(r.Header.Get("X-Filter")), &filter)
+
+			if listRequests > 1 && filter["id"] == nil {
+				t.Errorf("expected the watermark to be used in the filter: %s", r.Header.Get("X-Filter"))
+			}
+
+			// The stale event is always returned, the new one appears on the third request
+			events := []map[string]any{{"id": 5, "status": EventFinished}}
+			if listRequests > 2 {
+				events = append([]map[string]any{{"id": 6, "status": EventStarted}}, events...)
+			}
+
+			json.NewEncoder(rw).Encode(map[string]any{"data": events, "page": 1, "pages": 1, "results": len(events)})
+		case "/v4/account/events/6":
+			rw.Write([]byte(`{"id": 6, "status": "finished"}`))
+		default:
+			t.Errorf("unexpected request to %s", r.URL.Path)
+			rw.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	client := NewClient(nil)
+	client.SetBaseURL(ts.URL)
+	client.SetPollDelay(time.Millisecond)
+
+	p, err := client.NewEventPoller(context.Background(), 123, EntityLinode, ActionLinodeBoot)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Simulate the seeded events being beyond the first page
+	p.previousEvents = map[int]bool{}
+
+	event, err := p.WaitForFinished(context.Background(), 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if event.ID != 6 {
+		t.Errorf("expected the new event to be waited for, got event %d", event.ID)
+	}
+}
+
+func TestWaitForEventProgress(t *testing.T) {
+	responses := []string{
+		`{"id": 1, "status": "started", "percent_complete": 0}`,
+		`{"id": 1, "status": "started", "percent_complete": 40}`,
+		`{"id": 1, "status": "started", "percent_complete": 40}`,
+		`{"id": 1, "status": "finished", "percent_complete": 100}`,
+	}
+	requests := 0
+
+	ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/v4/account/events/1":
+			rw.Write([]byte(responses[requests]))
+			requests++
+		case "/v4/account/events/2":
+			rw.Write([]byte(`{"id": 2, "status": "finished", "percent_complete": null}`))
+		case "/v4/account/events/3":
+			rw.Write([]byte(`{"id": 3, "status": "failed", "percent_complete": 10}`))
+		default:
+			rw.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	client := NewClient(nil)
+	client.SetBaseURL(ts.URL)
+	client.SetPollDelay(time.Millisecond)
+
+	var progress []int
+	onProgress := func(percent int) { progress = append(progress, percent) }
+
+	event, err := client.WaitForEventProgress(context.Background(), 1, onProgress, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if event.Status != EventFinished || !reflect.DeepEqual(progress, []int{0, 40, 100}) {
+		t.Errorf("unexpected event %+v with progress %v", event, progress)
+	}
+
+	progress = nil
+
+	if _, err := client.WaitForEventProgress(context.Background(), 2, onProgress, 5); err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(progress, []int{100}) {
+		t.Errorf("expected only the final progress for an event without progress, got %v", progress)
+	}
+
+	event, err = client.WaitForEventProgress(context.Background(), 3, nil, 5)
+	if err == nil || event == nil || event.Status != EventFailed {
+		t.Errorf("expected the failed event and an error, got %+v and %v", event, err)
+	}
+}
+
+func TestWaitForResourceDeletion(t *testing.T) {
+	instanceRequests := 0
+
+	ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/v4/linode/instances/123":
+			instanceRequests++
+
+			if instanceRequests < 3 {
+				rw.Write([]byte(`{"id": 123, "status": "deleting"}`))
+				return
+			}
+
+			rw.WriteHeader(http.StatusNotFound)
+			rw.Write([]byte(`{"errors": [{"reason": "Not found"}]}`))
+		case "/v4/volumes/456":
+			rw.Write([]byte(`{"id": 456}`))
+		default:
+			rw.WriteHeader(http.StatusForbidden)
+			rw.Write([]byte(`{"errors": [{"reason": "Unauthorized"}]}`))
+		}
+	}))
+	defer ts.Close()
+
+	client := NewClient(nil)
+	client.SetBaseURL(ts.URL)
+	client.SetPollDelay(time.Millisecond)
+
+	if err := client.WaitForInstanceDeletion(context.Background(), 123, 5); err != nil {
+		t.Fatal(err)
+	}
+
+	if instanceRequests != 3 {
+		t.Errorf("expected the instance to be deleted after 3 requests, got %d", instanceRequests)
+	}
+
+	if err := client.WaitForVolumeDeletion(context.Background(), 456, 1); err == nil || !strings.Contains(err.Error(), "deadline exceeded") {
+		t.Errorf("expected a timeout for a volume that is not deleted, got %v", err)
+	}
+
+	err := client.WaitForResourceDeletion(context.Background(), func(ctx context.Context) error {
+		_, err := client.GetVolume(ctx, 789)
+		return err
+	}, 5)
+
+	var apiErr *Error
+	if !errors.As(err, &apiErr) || apiErr.Code != http.StatusForbidden {
+		t.Errorf("expected the getter error to be returned, got %v", err)
+	}
+}